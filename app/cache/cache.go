@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Cache interface
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, value []byte)
+	Delete(ctx context.Context, keys ...string)
+}
+
+// Key returns the key of the lookup of the given value
+func Key(lookup string, value string) string {
+	return lookup + ":" + value
+}
+
+// ReadThrough gets the value of the key from the cache, or loads it and stores it in the cache. The errors are not
+// cached, and the cache is skipped if it is nil
+func ReadThrough[T any](ctx context.Context, cache Cache, key string, load func() (*T, error)) (*T, error) {
+	if cache == nil {
+		return load()
+	}
+
+	// Get the value from the cache, an entry that can't be decoded is loaded again
+	if data, ok := cache.Get(ctx, key); ok {
+		value := new(T)
+		if err := json.Unmarshal(data, value); err == nil {
+			return value, nil
+		}
+	}
+
+	// Load the value and store it in the cache
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(value); err == nil {
+		cache.Set(ctx, key, data)
+	}
+	return value, nil
+}
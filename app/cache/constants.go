@@ -0,0 +1,46 @@
+package cache
+
+import "time"
+
+const (
+	// SizeKey is the key of the maximum number of entries of the in-memory cache
+	SizeKey = "USER_SERVICE_CACHE_SIZE"
+
+	// MemoryTTLKey is the key of the time an entry is kept in the in-memory cache
+	MemoryTTLKey = "USER_SERVICE_CACHE_MEMORY_TTL"
+
+	// RedisUriKey is the key of the Redis URI of the shared cache, which is disabled if it is not set
+	RedisUriKey = "USER_SERVICE_CACHE_REDIS_URI"
+
+	// RedisPasswordKey is the key of the Redis password of the shared cache
+	RedisPasswordKey = "USER_SERVICE_CACHE_REDIS_PASSWORD"
+
+	// RedisTTLKey is the key of the time an entry is kept in the shared cache
+	RedisTTLKey = "USER_SERVICE_CACHE_REDIS_TTL"
+
+	// Size is the default maximum number of entries of the in-memory cache
+	Size = 10000
+
+	// MemoryTTL is the default time an entry is kept in the in-memory cache, it bounds how long an instance may
+	// serve an entry invalidated by another instance
+	MemoryTTL = 30 * time.Second
+
+	// RedisTTL is the default time an entry is kept in the shared cache
+	RedisTTL = 5 * time.Minute
+
+	// KeyPrefix is the prefix of the shared cache keys
+	KeyPrefix = "user_service:cache"
+)
+
+// Cache tiers
+const (
+	TierMemory = "memory"
+	TierRedis  = "redis"
+)
+
+// Cached lookups, used as the prefix of the keys
+const (
+	UsernameByUserId  = "username"
+	UserIdByUsername  = "user_id"
+	ProfileByUsername = "profile"
+)
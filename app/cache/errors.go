@@ -0,0 +1,9 @@
+package cache
+
+import "errors"
+
+var (
+	NonPositiveSizeError = errors.New("cache size must be positive")
+	NonPositiveTTLError  = errors.New("cache TTL must be positive")
+	NilMemoryCacheError  = errors.New("memory cache cannot be nil")
+)
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type (
+	// entry is an in-memory cache entry
+	entry struct {
+		key       string
+		value     []byte
+		expiresAt time.Time
+	}
+
+	// MemoryCache is the in-memory LRU cache, whose entries expire after the TTL
+	MemoryCache struct {
+		mutex   sync.Mutex
+		size    int
+		ttl     time.Duration
+		order   *list.List
+		entries map[string]*list.Element
+	}
+)
+
+// NewMemoryCache creates a new in-memory LRU cache with the given maximum number of entries
+func NewMemoryCache(size int, ttl time.Duration) (*MemoryCache, error) {
+	// Check if either the size or the TTL is not positive
+	if size <= 0 {
+		return nil, NonPositiveSizeError
+	}
+	if ttl <= 0 {
+		return nil, NonPositiveTTLError
+	}
+
+	return &MemoryCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}, nil
+}
+
+// Get gets the value of the key if it has not expired, and marks it as the most recently used
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	// Remove the entry if it has expired
+	e := element.Value.(*entry)
+	if time.Now().After(e.expiresAt) {
+		m.remove(element)
+		return nil, false
+	}
+
+	m.order.MoveToFront(element)
+	return e.value, true
+}
+
+// Set sets the value of the key, evicting the least recently used entry if the cache is full
+func (m *MemoryCache) Set(key string, value []byte) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	expiresAt := time.Now().Add(m.ttl)
+
+	// Update the entry if it exists
+	if element, ok := m.entries[key]; ok {
+		e := element.Value.(*entry)
+		e.value = value
+		e.expiresAt = expiresAt
+		m.order.MoveToFront(element)
+		return
+	}
+
+	// Evict the least recently used entry
+	if m.order.Len() >= m.size {
+		m.remove(m.order.Back())
+	}
+
+	m.entries[key] = m.order.PushFront(&entry{key: key, value: value, expiresAt: expiresAt})
+}
+
+// Delete removes the keys
+func (m *MemoryCache) Delete(keys ...string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, key := range keys {
+		if element, ok := m.entries[key]; ok {
+			m.remove(element)
+		}
+	}
+}
+
+// remove removes the element from the list and the map
+func (m *MemoryCache) remove(element *list.Element) {
+	m.order.Remove(element)
+	delete(m.entries, element.Value.(*entry).key)
+}
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"github.com/go-redis/redis/v8"
+	commonredis "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/redis"
+	"time"
+)
+
+// RedisCache is the cache shared by the instances, kept in Redis
+type RedisCache struct {
+	redisClient *redis.Client
+	ttl         time.Duration
+}
+
+// NewRedisCache creates a new Redis cache
+func NewRedisCache(redisClient *redis.Client, ttl time.Duration) (*RedisCache, error) {
+	// Check if the Redis client is nil or the TTL is not positive
+	if redisClient == nil {
+		return nil, commonredis.NilClientError
+	}
+	if ttl <= 0 {
+		return nil, NonPositiveTTLError
+	}
+
+	return &RedisCache{redisClient: redisClient, ttl: ttl}, nil
+}
+
+// Get gets the value of the key
+func (r *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.redisClient.Get(ctx, commonredis.GetKey(key, KeyPrefix)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set sets the value of the key
+func (r *RedisCache) Set(ctx context.Context, key string, value []byte) error {
+	return r.redisClient.Set(ctx, commonredis.GetKey(key, KeyPrefix), value, r.ttl).Err()
+}
+
+// Delete removes the keys
+func (r *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	prefixedKeys := make([]string, len(keys))
+	for i, key := range keys {
+		prefixedKeys[i] = commonredis.GetKey(key, KeyPrefix)
+	}
+	return r.redisClient.Del(ctx, prefixedKeys...).Err()
+}
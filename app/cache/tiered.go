@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	appmetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/metrics"
+	"log/slog"
+	"strings"
+)
+
+// TieredCache is the cache that checks the in-memory cache first and then the optional shared cache. The shared
+// cache failures are logged and handled as misses, so the lookups fall back to the database
+type TieredCache struct {
+	memory *MemoryCache
+	shared *RedisCache
+	logger *slog.Logger
+}
+
+// NewTieredCache creates a new tiered cache, the shared cache is optional
+func NewTieredCache(memory *MemoryCache, shared *RedisCache, logger *slog.Logger) (*TieredCache, error) {
+	// Check if either the memory cache or the logger is nil
+	if memory == nil {
+		return nil, NilMemoryCacheError
+	}
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &TieredCache{memory: memory, shared: shared, logger: logger}, nil
+}
+
+// lookup returns the lookup of the key, used as the metrics label
+func lookup(key string) string {
+	name, _, _ := strings.Cut(key, ":")
+	return name
+}
+
+// failed logs and counts a shared cache failure
+func (t *TieredCache) failed(ctx context.Context, operation string, err error) {
+	appmetrics.CacheErrorsTotal.WithLabelValues(TierRedis, operation).Inc()
+	t.logger.LogAttrs(
+		ctx,
+		slog.LevelWarn,
+		"Shared cache "+operation+" failed",
+		appstructuredlogger.Error(err),
+	)
+}
+
+// Get gets the value of the key from the first tier that has it, the in-memory cache is filled on a shared cache
+// hit
+func (t *TieredCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	if value, ok := t.memory.Get(key); ok {
+		appmetrics.CacheHitsTotal.WithLabelValues(lookup(key), TierMemory).Inc()
+		return value, true
+	}
+
+	if t.shared != nil {
+		value, ok, err := t.shared.Get(ctx, key)
+		if err != nil {
+			t.failed(ctx, "get", err)
+		} else if ok {
+			appmetrics.CacheHitsTotal.WithLabelValues(lookup(key), TierRedis).Inc()
+			t.memory.Set(key, value)
+			return value, true
+		}
+	}
+
+	appmetrics.CacheMissesTotal.WithLabelValues(lookup(key)).Inc()
+	return nil, false
+}
+
+// Set sets the value of the key in every tier
+func (t *TieredCache) Set(ctx context.Context, key string, value []byte) {
+	t.memory.Set(key, value)
+
+	if t.shared != nil {
+		if err := t.shared.Set(ctx, key, value); err != nil {
+			t.failed(ctx, "set", err)
+		}
+	}
+}
+
+// Delete removes the keys from every tier
+func (t *TieredCache) Delete(ctx context.Context, keys ...string) {
+	t.memory.Delete(keys...)
+
+	if t.shared != nil {
+		if err := t.shared.Delete(ctx, keys...); err != nil {
+			t.failed(ctx, "delete", err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package clientip
+
+import (
+	"context"
+	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
+	"google.golang.org/grpc/metadata"
+	"net"
+	"strings"
+)
+
+// Resolver resolves the IP of the client that sent a request, which is forwarded by the trusted proxies
+type Resolver struct {
+	trustedProxies []*net.IPNet
+}
+
+// ParseNetworks parses the comma-separated IPs or CIDRs, an IP is parsed as a single address network
+func ParseNetworks(value string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, network := range strings.Split(value, ",") {
+		if network = strings.TrimSpace(network); network == "" {
+			continue
+		}
+
+		// Parse the IP as a single address network
+		if !strings.Contains(network, "/") {
+			ip := net.ParseIP(network)
+			if ip == nil {
+				return nil, InvalidNetworkError
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(network)
+		if err != nil {
+			return nil, InvalidNetworkError
+		}
+		networks = append(networks, ipNet)
+	}
+	return networks, nil
+}
+
+// Contains checks if the IP belongs to any of the networks
+func Contains(networks []*net.IPNet, ip string) bool {
+	parsedIp := net.ParseIP(ip)
+	if parsedIp == nil {
+		return false
+	}
+	for _, network := range networks {
+		if network.Contains(parsedIp) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewResolver creates a new client IP resolver with the comma-separated trusted proxies
+func NewResolver(trustedProxies string) (*Resolver, error) {
+	networks, err := ParseNetworks(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return &Resolver{trustedProxies: networks}, nil
+}
+
+// GetPeerIP returns the IP of the direct caller
+func (r *Resolver) GetPeerIP(ctx context.Context) (string, error) {
+	return commongrpcserverctx.GetClientIP(ctx)
+}
+
+// GetClientIP returns the IP of the client. If the direct caller is a trusted proxy, it is the last forwarded IP
+// that wasn't added by a trusted proxy, since the clients can send forged IPs before it
+func (r *Resolver) GetClientIP(ctx context.Context) (string, error) {
+	ip, err := r.GetPeerIP(ctx)
+	if err != nil || !Contains(r.trustedProxies, ip) {
+		return ip, err
+	}
+
+	// Get the forwarded IPs, from the client to the last proxy
+	md, _ := metadata.FromIncomingContext(ctx)
+	var forwardedIps []string
+	for _, value := range md.Get(ForwardedForMetadataKey) {
+		forwardedIps = append(forwardedIps, strings.Split(value, ",")...)
+	}
+
+	for i := len(forwardedIps) - 1; i >= 0; i-- {
+		forwardedIp := strings.TrimSpace(forwardedIps[i])
+		if net.ParseIP(forwardedIp) == nil {
+			break
+		}
+		ip = forwardedIp
+		if !Contains(r.trustedProxies, forwardedIp) {
+			break
+		}
+	}
+	return ip, nil
+}
@@ -0,0 +1,10 @@
+package clientip
+
+const (
+	// TrustedProxiesKey is the key of the comma-separated IPs or CIDRs of the proxies whose forwarded client IP is
+	// trusted, such as the gateway
+	TrustedProxiesKey = "USER_SERVICE_TRUSTED_PROXIES"
+
+	// ForwardedForMetadataKey is the metadata key of the client IPs forwarded by the proxies
+	ForwardedForMetadataKey = "x-forwarded-for"
+)
@@ -0,0 +1,7 @@
+package clientip
+
+import "errors"
+
+var (
+	InvalidNetworkError = errors.New("must be a comma-separated list of IPs or CIDRs")
+)
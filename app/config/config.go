@@ -0,0 +1,177 @@
+package config
+
+import (
+	appcache "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/cache"
+	appmongodb "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb"
+	userservervalidator "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/user/validator"
+	appidempotency "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/idempotency"
+	appjwt "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/jwt"
+	applifecycle "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/lifecycle"
+	appmail "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/mail"
+	apppasskey "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/passkey"
+	appratelimiter "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/ratelimiter"
+	apptracing "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/tracing"
+	"time"
+)
+
+type (
+	// Config is the configuration of the service
+	Config struct {
+		Port        string            `yaml:"port"`
+		MetricsPort string            `yaml:"metrics_port"`
+		MongoDB     MongoDBConfig     `yaml:"mongodb"`
+		Services    ServicesConfig    `yaml:"services"`
+		JWT         JWTConfig         `yaml:"jwt"`
+		Lifecycle   LifecycleConfig   `yaml:"lifecycle"`
+		Logging     LoggingConfig     `yaml:"logging"`
+		Tracing     TracingConfig     `yaml:"tracing"`
+		Network     NetworkConfig     `yaml:"network"`
+		RateLimiter RateLimiterConfig `yaml:"rate_limiter"`
+		Lookup      LookupConfig      `yaml:"lookup"`
+		Cache       CacheConfig       `yaml:"cache"`
+		Passkey     PasskeyConfig     `yaml:"passkey"`
+		Idempotency IdempotencyConfig `yaml:"idempotency"`
+		Mail        MailConfig        `yaml:"mail"`
+	}
+
+	// MongoDBConfig is the configuration of the MongoDB database
+	MongoDBConfig struct {
+		Uri               string        `yaml:"uri"`
+		Name              string        `yaml:"name"`
+		ConnectionTimeout time.Duration `yaml:"connection_timeout"`
+		QueryTimeout      time.Duration `yaml:"query_timeout"`
+		MethodTimeouts    string        `yaml:"method_timeouts"`
+	}
+
+	// ServicesConfig is the configuration of the called gRPC services
+	ServicesConfig struct {
+		AuthUri string `yaml:"auth_uri"`
+	}
+
+	// JWTConfig is the configuration of the JWT validation
+	JWTConfig struct {
+		PublicKey  string        `yaml:"public_key"`
+		KeysDir    string        `yaml:"keys_dir"`
+		JwksFile   string        `yaml:"jwks_file"`
+		KeyOverlap time.Duration `yaml:"key_overlap"`
+	}
+
+	// LifecycleConfig is the configuration of the shutdown sequence
+	LifecycleConfig struct {
+		GracefulStopTimeout time.Duration `yaml:"graceful_stop_timeout"`
+		StepTimeout         time.Duration `yaml:"step_timeout"`
+	}
+
+	// LoggingConfig is the configuration of the logs, the empty values use the defaults of the mode
+	LoggingConfig struct {
+		Level       string `yaml:"level"`
+		RedactEmail string `yaml:"redact_email"`
+		RedactPhone string `yaml:"redact_phone"`
+		RedactName  string `yaml:"redact_name"`
+	}
+
+	// TracingConfig is the configuration of the traces
+	TracingConfig struct {
+		Exporter string `yaml:"exporter"`
+	}
+
+	// NetworkConfig is the configuration of the network the requests come through
+	NetworkConfig struct {
+		TrustedProxies string `yaml:"trusted_proxies"`
+	}
+
+	// RateLimiterConfig is the configuration of the rate limiter, the method limits override the defaults
+	RateLimiterConfig struct {
+		Backend       string `yaml:"backend"`
+		RedisUri      string `yaml:"redis_uri"`
+		RedisPassword string `yaml:"redis_password"`
+		DefaultLimit  string `yaml:"default_limit"`
+		MethodLimits  string `yaml:"method_limits"`
+		ExemptCallers string `yaml:"exempt_callers"`
+	}
+
+	// LookupConfig is the configuration of the user lookups
+	LookupConfig struct {
+		BatchLimit int `yaml:"batch_limit"`
+	}
+
+	// CacheConfig is the configuration of the lookups cache, the shared Redis tier is disabled if its URI is empty
+	CacheConfig struct {
+		Size          int           `yaml:"size"`
+		MemoryTTL     time.Duration `yaml:"memory_ttl"`
+		RedisUri      string        `yaml:"redis_uri"`
+		RedisPassword string        `yaml:"redis_password"`
+		RedisTTL      time.Duration `yaml:"redis_ttl"`
+	}
+
+	// PasskeyConfig is the configuration of the WebAuthn relying party, the passkeys are disabled if its ID is empty
+	PasskeyConfig struct {
+		RPID          string        `yaml:"rp_id"`
+		RPDisplayName string        `yaml:"rp_display_name"`
+		RPOrigins     string        `yaml:"rp_origins"`
+		SessionTTL    time.Duration `yaml:"session_ttl"`
+	}
+
+	// IdempotencyConfig is the configuration of the idempotency keys of the mutating methods
+	IdempotencyConfig struct {
+		TTL    time.Duration `yaml:"ttl"`
+		Secret string        `yaml:"secret"`
+	}
+
+	// MailConfig is the configuration of the emails sent to the users, they are disabled if the SMTP host is empty
+	MailConfig struct {
+		SMTPHost         string        `yaml:"smtp_host"`
+		SMTPPort         string        `yaml:"smtp_port"`
+		SMTPUsername     string        `yaml:"smtp_username"`
+		SMTPPassword     string        `yaml:"smtp_password"`
+		From             string        `yaml:"from"`
+		ResetPasswordUrl string        `yaml:"reset_password_url"`
+		ResetPasswordTTL time.Duration `yaml:"reset_password_ttl"`
+		VerifyEmailUrl   string        `yaml:"verify_email_url"`
+		VerifyEmailTTL   time.Duration `yaml:"verify_email_ttl"`
+	}
+)
+
+// NewDefaultConfig creates a new config with the default values
+func NewDefaultConfig() *Config {
+	return &Config{
+		MongoDB: MongoDBConfig{
+			ConnectionTimeout: appmongodb.ConnectionCtxTimeout,
+			QueryTimeout:      appmongodb.QueryCtxTimeout,
+		},
+		JWT: JWTConfig{
+			KeyOverlap: appjwt.KeyOverlap,
+		},
+		Lifecycle: LifecycleConfig{
+			GracefulStopTimeout: applifecycle.GracefulStopTimeout,
+			StepTimeout:         applifecycle.StepTimeout,
+		},
+		Tracing: TracingConfig{
+			Exporter: apptracing.ExporterNone,
+		},
+		RateLimiter: RateLimiterConfig{
+			Backend:      appratelimiter.BackendMemory,
+			DefaultLimit: appratelimiter.DefaultLimit.String(),
+		},
+		Lookup: LookupConfig{
+			BatchLimit: userservervalidator.BatchLimit,
+		},
+		Cache: CacheConfig{
+			Size:      appcache.Size,
+			MemoryTTL: appcache.MemoryTTL,
+			RedisTTL:  appcache.RedisTTL,
+		},
+		Passkey: PasskeyConfig{
+			RPDisplayName: apppasskey.RPDisplayName,
+			SessionTTL:    apppasskey.SessionTTL,
+		},
+		Idempotency: IdempotencyConfig{
+			TTL: appidempotency.TTL,
+		},
+		Mail: MailConfig{
+			SMTPPort:         appmail.Port,
+			ResetPasswordTTL: appmail.ResetPasswordTTL,
+			VerifyEmailTTL:   appmail.VerifyEmailTTL,
+		},
+	}
+}
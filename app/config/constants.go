@@ -0,0 +1,15 @@
+package config
+
+const (
+	// FileKey is the key of the YAML config file path
+	FileKey = "CONFIG_FILE"
+
+	// FileFlag is the flag of the YAML config file path, it takes precedence over the environment variable
+	FileFlag = "config"
+
+	// PrintFlag is the flag that prints the effective config with the secrets redacted and exits
+	PrintFlag = "print-config"
+
+	// Redacted replaces the secrets when the config is printed
+	Redacted = "[REDACTED]"
+)
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	FailedToReadFileError  = errors.New("failed to read config file")
+	FailedToParseFileError = errors.New("failed to parse config file")
+	RequiredError          = errors.New("is required")
+	InvalidPortError       = errors.New("must be a port between 1 and 65535")
+	InvalidDurationError   = errors.New("must be a positive duration")
+	InvalidIntegerError    = errors.New("must be a positive integer")
+	InvalidValueError      = errors.New("has an invalid value")
+	OneKeySourceError      = errors.New("requires exactly one of public_key, keys_dir or jwks_file")
+)
+
+// FieldError is the error of a config field
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+// Error returns the error message with the field path
+func (f FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", f.Path, f.Err.Error())
+}
+
+// Unwrap returns the wrapped error
+func (f FieldError) Unwrap() error {
+	return f.Err
+}
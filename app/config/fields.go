@@ -0,0 +1,269 @@
+package config
+
+import (
+	appcache "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/cache"
+	appclientip "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/clientip"
+	appmongodb "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb"
+	appmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb/user"
+	appgrpc "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc"
+	userservervalidator "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/user/validator"
+	appidempotency "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/idempotency"
+	appjwt "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/jwt"
+	applifecycle "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/lifecycle"
+	applistener "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/listener"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	appmail "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/mail"
+	appmetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/metrics"
+	apppasskey "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/passkey"
+	appratelimiter "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/ratelimiter"
+	apptracing "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/tracing"
+	"strconv"
+	"time"
+)
+
+// field is a config field that can be set from an environment variable and a flag
+type field struct {
+	path   string
+	env    string
+	flag   string
+	usage  string
+	secret bool
+	get    func() string
+	set    func(value string) error
+}
+
+// stringField creates a new string field
+func stringField(path, env, flag, usage string, value *string) *field {
+	return &field{
+		path:  path,
+		env:   env,
+		flag:  flag,
+		usage: usage,
+		get: func() string {
+			return *value
+		},
+		set: func(v string) error {
+			*value = v
+			return nil
+		},
+	}
+}
+
+// secretField creates a new string field whose value is redacted when the config is printed
+func secretField(path, env, flag, usage string, value *string) *field {
+	f := stringField(path, env, flag, usage, value)
+	f.secret = true
+	return f
+}
+
+// durationField creates a new duration field
+func durationField(path, env, flag, usage string, value *time.Duration) *field {
+	return &field{
+		path:  path,
+		env:   env,
+		flag:  flag,
+		usage: usage,
+		get: func() string {
+			return value.String()
+		},
+		set: func(v string) error {
+			duration, err := time.ParseDuration(v)
+			if err != nil {
+				return InvalidDurationError
+			}
+			*value = duration
+			return nil
+		},
+	}
+}
+
+// intField creates a new integer field
+func intField(path, env, flag, usage string, value *int) *field {
+	return &field{
+		path:  path,
+		env:   env,
+		flag:  flag,
+		usage: usage,
+		get: func() string {
+			return strconv.Itoa(*value)
+		},
+		set: func(v string) error {
+			number, err := strconv.Atoi(v)
+			if err != nil {
+				return InvalidIntegerError
+			}
+			*value = number
+			return nil
+		},
+	}
+}
+
+// fields returns the fields of the config, in the order they are printed
+func (c *Config) fields() []*field {
+	return []*field{
+		stringField("port", applistener.PortKey, "port", "gRPC server port", &c.Port),
+		stringField("metrics_port", appmetrics.PortKey, "metrics-port", "metrics server port", &c.MetricsPort),
+		secretField("mongodb.uri", appmongodbuser.UriKey, "mongodb-uri", "MongoDB URI", &c.MongoDB.Uri),
+		stringField("mongodb.name", appmongodbuser.DbNameKey, "mongodb-name", "MongoDB database name", &c.MongoDB.Name),
+		durationField(
+			"mongodb.connection_timeout", appmongodb.ConnectionCtxTimeoutKey, "mongodb-connection-timeout",
+			"MongoDB connection timeout", &c.MongoDB.ConnectionTimeout,
+		),
+		durationField(
+			"mongodb.query_timeout", appmongodb.QueryCtxTimeoutKey, "mongodb-query-timeout",
+			"timeout of the methods without their own timeout", &c.MongoDB.QueryTimeout,
+		),
+		stringField(
+			"mongodb.method_timeouts", appmongodb.MethodQueryCtxTimeoutsKey, "mongodb-method-timeouts",
+			"comma-separated Method=duration timeouts that override the defaults", &c.MongoDB.MethodTimeouts,
+		),
+		stringField("services.auth_uri", appgrpc.AuthServiceUriKey, "auth-uri", "auth service URI", &c.Services.AuthUri),
+		stringField("jwt.public_key", appjwt.PublicKey, "jwt-public-key", "JWT ED25519 public key", &c.JWT.PublicKey),
+		stringField(
+			"jwt.keys_dir", appjwt.KeysDirKey, "jwt-keys-dir",
+			"directory with a <kid>.pem file per JWT ED25519 public key", &c.JWT.KeysDir,
+		),
+		stringField(
+			"jwt.jwks_file", appjwt.JwksFileKey, "jwt-jwks-file",
+			"JWKS file with the JWT ED25519 public keys", &c.JWT.JwksFile,
+		),
+		durationField(
+			"jwt.key_overlap", appjwt.KeyOverlapKey, "jwt-key-overlap",
+			"time a removed JWT public key is still accepted", &c.JWT.KeyOverlap,
+		),
+		durationField(
+			"lifecycle.graceful_stop_timeout", applifecycle.GracefulStopTimeoutKey, "graceful-stop-timeout",
+			"maximum time to drain the in-flight requests", &c.Lifecycle.GracefulStopTimeout,
+		),
+		durationField(
+			"lifecycle.step_timeout", applifecycle.StepTimeoutKey, "shutdown-step-timeout",
+			"timeout of each shutdown step", &c.Lifecycle.StepTimeout,
+		),
+		stringField("logging.level", appstructuredlogger.LevelKey, "log-level", "log level", &c.Logging.Level),
+		stringField(
+			"logging.redact_email", appstructuredlogger.RedactEmailKey, "log-redact-email",
+			"email mask: none, partial or full", &c.Logging.RedactEmail,
+		),
+		stringField(
+			"logging.redact_phone", appstructuredlogger.RedactPhoneKey, "log-redact-phone",
+			"phone number mask: none, partial or full", &c.Logging.RedactPhone,
+		),
+		stringField(
+			"logging.redact_name", appstructuredlogger.RedactNameKey, "log-redact-name",
+			"name mask: none, partial or full", &c.Logging.RedactName,
+		),
+		stringField(
+			"tracing.exporter", apptracing.ExporterKey, "traces-exporter",
+			"traces exporter: otlp, stdout or none", &c.Tracing.Exporter,
+		),
+		stringField(
+			"network.trusted_proxies", appclientip.TrustedProxiesKey, "trusted-proxies",
+			"comma-separated IPs or CIDRs of the proxies whose forwarded client IPs are trusted",
+			&c.Network.TrustedProxies,
+		),
+		stringField(
+			"rate_limiter.backend", appratelimiter.BackendKey, "rate-limiter-backend",
+			"rate limiter backend: memory or redis", &c.RateLimiter.Backend,
+		),
+		stringField(
+			"rate_limiter.redis_uri", appratelimiter.RedisUriKey, "rate-limiter-redis-uri",
+			"rate limiter Redis URI", &c.RateLimiter.RedisUri,
+		),
+		secretField(
+			"rate_limiter.redis_password", appratelimiter.RedisPasswordKey, "rate-limiter-redis-password",
+			"rate limiter Redis password", &c.RateLimiter.RedisPassword,
+		),
+		stringField(
+			"rate_limiter.default_limit", appratelimiter.DefaultLimitKey, "rate-limiter-default-limit",
+			"limit of the methods without their own limit, as burst/refill period", &c.RateLimiter.DefaultLimit,
+		),
+		stringField(
+			"rate_limiter.method_limits", appratelimiter.MethodLimitsKey, "rate-limiter-method-limits",
+			"comma-separated Method=burst/refill period limits that override the defaults", &c.RateLimiter.MethodLimits,
+		),
+		stringField(
+			"rate_limiter.exempt_callers", appratelimiter.ExemptCallersKey, "rate-limiter-exempt-callers",
+			"comma-separated IPs or CIDRs of the services that are not rate limited", &c.RateLimiter.ExemptCallers,
+		),
+		intField(
+			"lookup.batch_limit", userservervalidator.BatchLimitKey, "lookup-batch-limit",
+			"maximum number of items resolved by a batch lookup", &c.Lookup.BatchLimit,
+		),
+		intField(
+			"cache.size", appcache.SizeKey, "cache-size",
+			"maximum number of entries of the in-memory lookups cache", &c.Cache.Size,
+		),
+		durationField(
+			"cache.memory_ttl", appcache.MemoryTTLKey, "cache-memory-ttl",
+			"time an entry is kept in the in-memory lookups cache", &c.Cache.MemoryTTL,
+		),
+		stringField(
+			"cache.redis_uri", appcache.RedisUriKey, "cache-redis-uri",
+			"shared lookups cache Redis URI, the shared cache is disabled if it is empty", &c.Cache.RedisUri,
+		),
+		secretField(
+			"cache.redis_password", appcache.RedisPasswordKey, "cache-redis-password",
+			"shared lookups cache Redis password", &c.Cache.RedisPassword,
+		),
+		durationField(
+			"cache.redis_ttl", appcache.RedisTTLKey, "cache-redis-ttl",
+			"time an entry is kept in the shared lookups cache", &c.Cache.RedisTTL,
+		),
+		stringField(
+			"passkey.rp_id", apppasskey.RPIDKey, "passkey-rp-id",
+			"WebAuthn relying party ID, the passkeys are disabled if it is empty", &c.Passkey.RPID,
+		),
+		stringField(
+			"passkey.rp_display_name", apppasskey.RPDisplayNameKey, "passkey-rp-display-name",
+			"WebAuthn relying party name shown by the authenticators", &c.Passkey.RPDisplayName,
+		),
+		stringField(
+			"passkey.rp_origins", apppasskey.RPOriginsKey, "passkey-rp-origins",
+			"comma-separated origins allowed to run the passkey ceremonies", &c.Passkey.RPOrigins,
+		),
+		durationField(
+			"passkey.session_ttl", apppasskey.SessionTTLKey, "passkey-session-ttl",
+			"time a passkey ceremony can be finished after it was started", &c.Passkey.SessionTTL,
+		),
+		durationField(
+			"idempotency.ttl", appidempotency.TTLKey, "idempotency-ttl",
+			"time the response of a request sent with an idempotency key is replayed to its retries",
+			&c.Idempotency.TTL,
+		),
+		secretField(
+			"idempotency.secret", appidempotency.SecretKey, "idempotency-secret",
+			"secret the fingerprints of the requests sent with an idempotency key are signed with",
+			&c.Idempotency.Secret,
+		),
+		stringField(
+			"mail.smtp_host", appmail.HostKey, "smtp-host",
+			"SMTP server host, the emails are disabled if it is empty", &c.Mail.SMTPHost,
+		),
+		stringField("mail.smtp_port", appmail.PortKey, "smtp-port", "SMTP server port", &c.Mail.SMTPPort),
+		stringField(
+			"mail.smtp_username", appmail.UsernameKey, "smtp-username",
+			"SMTP server username, the server is not authenticated if it is empty", &c.Mail.SMTPUsername,
+		),
+		secretField(
+			"mail.smtp_password", appmail.PasswordKey, "smtp-password", "SMTP server password",
+			&c.Mail.SMTPPassword,
+		),
+		stringField("mail.from", appmail.FromKey, "mail-from", "sender address of the emails", &c.Mail.From),
+		stringField(
+			"mail.reset_password_url", appmail.ResetPasswordUrlKey, "reset-password-url",
+			"URL of the page where the users reset their password", &c.Mail.ResetPasswordUrl,
+		),
+		durationField(
+			"mail.reset_password_ttl", appmail.ResetPasswordTTLKey, "reset-password-ttl",
+			"time a password reset link can be used", &c.Mail.ResetPasswordTTL,
+		),
+		stringField(
+			"mail.verify_email_url", appmail.VerifyEmailUrlKey, "verify-email-url",
+			"URL of the page where the users verify their emails", &c.Mail.VerifyEmailUrl,
+		),
+		durationField(
+			"mail.verify_email_ttl", appmail.VerifyEmailTTLKey, "verify-email-ttl",
+			"time an email verification link can be used", &c.Mail.VerifyEmailTTL,
+		),
+	}
+}
@@ -0,0 +1,124 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+)
+
+var (
+	// file is the YAML config file path set by the flag
+	file string
+
+	// printConfig is set by the flag that prints the effective config
+	printConfig bool
+
+	// flagValues are the values of the config fields set by the flags, keyed by flag name
+	flagValues = make(map[string]*string)
+)
+
+// SetFlags declares the config flags, they must be declared before the flags are parsed
+func SetFlags() {
+	flag.StringVar(
+		&file,
+		FileFlag,
+		"",
+		"Specify the path of the YAML config file. Overrides the "+FileKey+" environment variable",
+	)
+	flag.BoolVar(
+		&printConfig,
+		PrintFlag,
+		false,
+		"Print the effective config with the secrets redacted and exit",
+	)
+
+	// Declare a flag for each config field
+	for _, f := range NewDefaultConfig().fields() {
+		value := new(string)
+		flag.StringVar(value, f.flag, "", "Set the "+f.usage+". Overrides the "+f.env+" environment variable")
+		flagValues[f.flag] = value
+	}
+}
+
+// IsPrintRequested returns true if the effective config must be printed instead of starting the service
+func IsPrintRequested() bool {
+	return printConfig
+}
+
+// Load loads the config from the defaults, the YAML file, the environment variables and the flags, each
+// source taking precedence over the previous one. Every invalid value is reported at once
+func Load() (*Config, error) {
+	config := NewDefaultConfig()
+
+	// Load the YAML config file if it is set
+	path := file
+	if path == "" {
+		path = os.Getenv(FileKey)
+	}
+	if path != "" {
+		if err := config.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the flags that were set
+	setFlags := make(map[string]bool)
+	flag.Visit(
+		func(f *flag.Flag) {
+			setFlags[f.Name] = true
+		},
+	)
+
+	// Load the environment variables and the flags
+	var errs []error
+	for _, f := range config.fields() {
+		if value, ok := os.LookupEnv(f.env); ok {
+			if err := f.set(value); err != nil {
+				errs = append(errs, FieldError{Path: f.path, Err: err})
+			}
+		}
+		if setFlags[f.flag] {
+			if err := f.set(*flagValues[f.flag]); err != nil {
+				errs = append(errs, FieldError{Path: f.path, Err: err})
+			}
+		}
+	}
+
+	// Validate the whole config
+	if err := errors.Join(append(errs, config.Validate()...)...); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// loadFile loads the YAML config file, the unknown fields are rejected
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Join(FailedToReadFileError, err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return errors.Join(FailedToParseFileError, err)
+	}
+	return nil
+}
+
+// Print writes the config as YAML with the secrets redacted
+func (c *Config) Print(w io.Writer) error {
+	redacted := *c
+	for _, f := range redacted.fields() {
+		if f.secret && f.get() != "" {
+			_ = f.set(Redacted)
+		}
+	}
+
+	encoder := yaml.NewEncoder(w)
+	defer encoder.Close()
+	return encoder.Encode(&redacted)
+}
@@ -0,0 +1,213 @@
+package config
+
+import (
+	appclientip "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/clientip"
+	appmongodb "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb"
+	appidempotency "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/idempotency"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	appmail "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/mail"
+	apppasskey "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/passkey"
+	appratelimiter "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/ratelimiter"
+	apptracing "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/tracing"
+	"strconv"
+	"time"
+)
+
+// validateRequired checks if the value is set
+func validateRequired(path string, value string, errs *[]error) {
+	if value == "" {
+		*errs = append(*errs, FieldError{Path: path, Err: RequiredError})
+	}
+}
+
+// validatePort checks if the value is a valid port
+func validatePort(path string, value string, errs *[]error) {
+	if value == "" {
+		*errs = append(*errs, FieldError{Path: path, Err: RequiredError})
+		return
+	}
+
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		*errs = append(*errs, FieldError{Path: path, Err: InvalidPortError})
+	}
+}
+
+// validateDuration checks if the duration is positive
+func validateDuration(path string, value time.Duration, errs *[]error) {
+	if value <= 0 {
+		*errs = append(*errs, FieldError{Path: path, Err: InvalidDurationError})
+	}
+}
+
+// validatePositive checks if the integer is positive
+func validatePositive(path string, value int, errs *[]error) {
+	if value <= 0 {
+		*errs = append(*errs, FieldError{Path: path, Err: InvalidIntegerError})
+	}
+}
+
+// validateOneOf checks if the value is one of the allowed values
+func validateOneOf(path string, value string, errs *[]error, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	*errs = append(*errs, FieldError{Path: path, Err: InvalidValueError})
+}
+
+// validateOptional checks the value with the given function if it is set
+func validateOptional(path string, value string, errs *[]error, validate func(string) error) {
+	if value == "" {
+		return
+	}
+	if err := validate(value); err != nil {
+		*errs = append(*errs, FieldError{Path: path, Err: err})
+	}
+}
+
+// validateJWTKeySource checks if exactly one source of the JWT public keys is set
+func validateJWTKeySource(jwt JWTConfig, errs *[]error) {
+	sources := 0
+	for _, value := range []string{jwt.PublicKey, jwt.KeysDir, jwt.JwksFile} {
+		if value != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		*errs = append(*errs, FieldError{Path: "jwt", Err: OneKeySourceError})
+	}
+}
+
+// Validate validates the config and returns every error found
+func (c *Config) Validate() []error {
+	var errs []error
+
+	validatePort("port", c.Port, &errs)
+	validatePort("metrics_port", c.MetricsPort, &errs)
+
+	validateRequired("mongodb.uri", c.MongoDB.Uri, &errs)
+	validateRequired("mongodb.name", c.MongoDB.Name, &errs)
+	validateDuration("mongodb.connection_timeout", c.MongoDB.ConnectionTimeout, &errs)
+	validateDuration("mongodb.query_timeout", c.MongoDB.QueryTimeout, &errs)
+	validateOptional(
+		"mongodb.method_timeouts", c.MongoDB.MethodTimeouts, &errs, func(value string) error {
+			_, err := appmongodb.NewMethodQueryCtxTimeouts(value)
+			return err
+		},
+	)
+
+	validateRequired("services.auth_uri", c.Services.AuthUri, &errs)
+	validateJWTKeySource(c.JWT, &errs)
+	validateDuration("jwt.key_overlap", c.JWT.KeyOverlap, &errs)
+
+	validateDuration("lifecycle.graceful_stop_timeout", c.Lifecycle.GracefulStopTimeout, &errs)
+	validateDuration("lifecycle.step_timeout", c.Lifecycle.StepTimeout, &errs)
+
+	validateOptional(
+		"logging.level", c.Logging.Level, &errs, func(value string) error {
+			_, err := appstructuredlogger.ParseLevel(value)
+			return err
+		},
+	)
+	for _, mask := range []struct {
+		path  string
+		value string
+	}{
+		{"logging.redact_email", c.Logging.RedactEmail},
+		{"logging.redact_phone", c.Logging.RedactPhone},
+		{"logging.redact_name", c.Logging.RedactName},
+	} {
+		validateOptional(
+			mask.path, mask.value, &errs, func(value string) error {
+				_, err := appstructuredlogger.ParseMask(value)
+				return err
+			},
+		)
+	}
+
+	validateOneOf(
+		"tracing.exporter", c.Tracing.Exporter, &errs,
+		apptracing.ExporterOTLP, apptracing.ExporterStdout, apptracing.ExporterNone,
+	)
+
+	validateOptional(
+		"network.trusted_proxies", c.Network.TrustedProxies, &errs, func(value string) error {
+			_, err := appclientip.ParseNetworks(value)
+			return err
+		},
+	)
+
+	validateOneOf(
+		"rate_limiter.backend", c.RateLimiter.Backend, &errs,
+		appratelimiter.BackendMemory, appratelimiter.BackendRedis,
+	)
+	if c.RateLimiter.Backend == appratelimiter.BackendRedis {
+		validateRequired("rate_limiter.redis_uri", c.RateLimiter.RedisUri, &errs)
+	}
+	validateRequired("rate_limiter.default_limit", c.RateLimiter.DefaultLimit, &errs)
+	validateOptional(
+		"rate_limiter.default_limit", c.RateLimiter.DefaultLimit, &errs, func(value string) error {
+			_, err := appratelimiter.ParseLimit(value)
+			return err
+		},
+	)
+	validateOptional(
+		"rate_limiter.method_limits", c.RateLimiter.MethodLimits, &errs, func(value string) error {
+			_, err := appratelimiter.NewMethodLimits(value)
+			return err
+		},
+	)
+	validateOptional(
+		"rate_limiter.exempt_callers", c.RateLimiter.ExemptCallers, &errs, func(value string) error {
+			_, err := appclientip.ParseNetworks(value)
+			return err
+		},
+	)
+
+	validatePositive("lookup.batch_limit", c.Lookup.BatchLimit, &errs)
+
+	validatePositive("cache.size", c.Cache.Size, &errs)
+	validateDuration("cache.memory_ttl", c.Cache.MemoryTTL, &errs)
+	validateDuration("cache.redis_ttl", c.Cache.RedisTTL, &errs)
+
+	if c.Passkey.RPID != "" {
+		validateRequired("passkey.rp_display_name", c.Passkey.RPDisplayName, &errs)
+		validateRequired("passkey.rp_origins", c.Passkey.RPOrigins, &errs)
+		validateOptional(
+			"passkey.rp_origins", c.Passkey.RPOrigins, &errs, func(value string) error {
+				_, err := apppasskey.ParseOrigins(value)
+				return err
+			},
+		)
+		validateDuration("passkey.session_ttl", c.Passkey.SessionTTL, &errs)
+	}
+
+	validateDuration("idempotency.ttl", c.Idempotency.TTL, &errs)
+	validateRequired("idempotency.secret", c.Idempotency.Secret, &errs)
+	validateOptional("idempotency.secret", c.Idempotency.Secret, &errs, appidempotency.ValidateSecret)
+
+	if c.Mail.SMTPHost != "" {
+		validatePort("mail.smtp_port", c.Mail.SMTPPort, &errs)
+		validateRequired("mail.from", c.Mail.From, &errs)
+		validateRequired("mail.reset_password_url", c.Mail.ResetPasswordUrl, &errs)
+		validateOptional(
+			"mail.reset_password_url", c.Mail.ResetPasswordUrl, &errs, func(value string) error {
+				_, err := appmail.ParseLinkUrl(value)
+				return err
+			},
+		)
+		validateDuration("mail.reset_password_ttl", c.Mail.ResetPasswordTTL, &errs)
+		validateRequired("mail.verify_email_url", c.Mail.VerifyEmailUrl, &errs)
+		validateOptional(
+			"mail.verify_email_url", c.Mail.VerifyEmailUrl, &errs, func(value string) error {
+				_, err := appmail.ParseLinkUrl(value)
+				return err
+			},
+		)
+		validateDuration("mail.verify_email_ttl", c.Mail.VerifyEmailTTL, &errs)
+	}
+
+	return errs
+}
@@ -1,8 +1,83 @@
 package mongodb
 
-import "time"
+import (
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	appgrpc "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc"
+	pbconfiguser "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/user"
+	pbconfiguseradmin "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/useradmin"
+	"maps"
+	"time"
+)
 
 const (
+	// ConnectionCtxTimeoutKey is the key of the MongoDB connection timeout
+	ConnectionCtxTimeoutKey = "USER_SERVICE_MONGODB_CONNECTION_TIMEOUT"
+
+	// QueryCtxTimeoutKey is the key of the MongoDB query timeout
+	QueryCtxTimeoutKey = "USER_SERVICE_MONGODB_QUERY_TIMEOUT"
+
+	// MethodQueryCtxTimeoutsKey is the key of the MongoDB query timeouts of the methods
+	MethodQueryCtxTimeoutsKey = "USER_SERVICE_MONGODB_METHOD_TIMEOUTS"
+
 	// ConnectionCtxTimeout is the timeout for the MongoDB connection
 	ConnectionCtxTimeout = 60 * time.Second
+
+	// QueryCtxTimeout is the timeout for the queries of the methods without their own timeout
+	QueryCtxTimeout = 10 * time.Second
+
+	// TransactionCtxTimeout is the default timeout for the queries of the methods that run transactions or call
+	// other services
+	TransactionCtxTimeout = 15 * time.Second
+)
+
+var (
+	// MethodQueryCtxTimeouts are the default timeouts for the queries of the methods that run transactions or call
+	// other services
+	MethodQueryCtxTimeouts = map[pbtypesgrpc.Method]time.Duration{
+		pbconfiguser.SignUp:                    TransactionCtxTimeout,
+		pbconfiguser.VerifySecondFactor:        TransactionCtxTimeout,
+		pbconfiguser.EnrollTOTP:                TransactionCtxTimeout,
+		pbconfiguser.ConfirmTOTP:               TransactionCtxTimeout,
+		pbconfiguser.DisableTOTP:               TransactionCtxTimeout,
+		pbconfiguser.FinishPasskeyRegistration: TransactionCtxTimeout,
+		pbconfiguser.FinishPasskeyAssertion:    TransactionCtxTimeout,
+		pbconfiguser.RevokePasskey:             TransactionCtxTimeout,
+		pbconfiguser.ChangePassword:            TransactionCtxTimeout,
+		pbconfiguser.ChangeUsername:            TransactionCtxTimeout,
+		pbconfiguser.AddEmail:                  TransactionCtxTimeout,
+		pbconfiguser.DeleteEmail:               TransactionCtxTimeout,
+		pbconfiguser.SendVerificationEmail:     TransactionCtxTimeout,
+		pbconfiguser.VerifyEmail:               TransactionCtxTimeout,
+		pbconfiguser.ChangePrimaryEmail:        TransactionCtxTimeout,
+		pbconfiguser.ChangePhoneNumber:         TransactionCtxTimeout,
+		pbconfiguser.ForgotPassword:            TransactionCtxTimeout,
+		pbconfiguser.ResetPassword:             TransactionCtxTimeout,
+		pbconfiguser.GetMyProfile:              TransactionCtxTimeout,
+		pbconfiguser.DeleteUser:                TransactionCtxTimeout,
+		pbconfiguseradmin.LockUser:             TransactionCtxTimeout,
+		pbconfiguseradmin.UnlockUser:           TransactionCtxTimeout,
+		pbconfiguseradmin.ForcePasswordReset:   TransactionCtxTimeout,
+		pbconfiguseradmin.RestoreUser:          TransactionCtxTimeout,
+		pbconfiguseradmin.RevokeUserSessions:   TransactionCtxTimeout,
+		pbconfiguseradmin.GrantUserRole:        TransactionCtxTimeout,
+		pbconfiguseradmin.RevokeUserRole:       TransactionCtxTimeout,
+	}
 )
+
+// NewMethodQueryCtxTimeouts returns the default method timeouts with the comma-separated Method=duration overrides
+func NewMethodQueryCtxTimeouts(overrides string) (*map[pbtypesgrpc.Method]time.Duration, error) {
+	values, err := appgrpc.ParseMethodValues(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	methodTimeouts := maps.Clone(MethodQueryCtxTimeouts)
+	for method, value := range values {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return nil, InvalidMethodTimeoutError
+		}
+		methodTimeouts[method] = timeout
+	}
+	return &methodTimeouts, nil
+}
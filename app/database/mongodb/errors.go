@@ -0,0 +1,7 @@
+package mongodb
+
+import "errors"
+
+var (
+	InvalidMethodTimeoutError = errors.New("method timeout must be a positive duration, like 15s")
+)
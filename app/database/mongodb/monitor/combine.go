@@ -0,0 +1,33 @@
+package monitor
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// CombineCommandMonitors creates a command monitor that forwards every event to the given monitors
+func CombineCommandMonitors(monitors ...*event.CommandMonitor) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, started *event.CommandStartedEvent) {
+			for _, monitor := range monitors {
+				if monitor.Started != nil {
+					monitor.Started(ctx, started)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, succeeded *event.CommandSucceededEvent) {
+			for _, monitor := range monitors {
+				if monitor.Succeeded != nil {
+					monitor.Succeeded(ctx, succeeded)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, failed *event.CommandFailedEvent) {
+			for _, monitor := range monitors {
+				if monitor.Failed != nil {
+					monitor.Failed(ctx, failed)
+				}
+			}
+		},
+	}
+}
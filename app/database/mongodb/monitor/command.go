@@ -0,0 +1,83 @@
+package monitor
+
+import (
+	"context"
+	appmetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/metrics"
+	"go.mongodb.org/mongo-driver/event"
+	"sync"
+)
+
+const (
+	// ResultSucceeded is the result label of a succeeded command
+	ResultSucceeded = "succeeded"
+
+	// ResultFailed is the result label of a failed command
+	ResultFailed = "failed"
+)
+
+// CommandMetrics records the MongoDB commands count and latency
+type CommandMetrics struct {
+	collections sync.Map
+}
+
+// NewCommandMetrics creates a new MongoDB command metrics recorder
+func NewCommandMetrics() *CommandMetrics {
+	return &CommandMetrics{}
+}
+
+// getCollectionName gets the collection name targeted by the command
+func getCollectionName(started *event.CommandStartedEvent) string {
+	// Most commands have the collection name as the value of the command key
+	if collection, ok := started.Command.Lookup(started.CommandName).StringValueOK(); ok {
+		return collection
+	}
+
+	// The getMore command has it in the collection key
+	if collection, ok := started.Command.Lookup("collection").StringValueOK(); ok {
+		return collection
+	}
+	return ""
+}
+
+// started stores the collection name of the started command
+func (c *CommandMetrics) started(
+	ctx context.Context,
+	started *event.CommandStartedEvent,
+) {
+	c.collections.Store(started.RequestID, getCollectionName(started))
+}
+
+// finished records the metrics of the finished command
+func (c *CommandMetrics) finished(
+	finished *event.CommandFinishedEvent,
+	result string,
+) {
+	// Get the collection name stored when the command started
+	collection := ""
+	if value, ok := c.collections.LoadAndDelete(finished.RequestID); ok {
+		collection = value.(string)
+	}
+
+	appmetrics.MongoDBCommandsTotal.WithLabelValues(
+		collection,
+		finished.CommandName,
+		result,
+	).Inc()
+	appmetrics.MongoDBCommandDuration.WithLabelValues(
+		collection,
+		finished.CommandName,
+	).Observe(finished.Duration.Seconds())
+}
+
+// Monitor returns the MongoDB command monitor
+func (c *CommandMetrics) Monitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: c.started,
+		Succeeded: func(ctx context.Context, succeeded *event.CommandSucceededEvent) {
+			c.finished(&succeeded.CommandFinishedEvent, ResultSucceeded)
+		},
+		Failed: func(ctx context.Context, failed *event.CommandFailedEvent) {
+			c.finished(&failed.CommandFinishedEvent, ResultFailed)
+		},
+	}
+}
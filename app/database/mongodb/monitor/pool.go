@@ -0,0 +1,37 @@
+package monitor
+
+import (
+	appmetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/metrics"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+const (
+	// StateOpen is the state label of the open connections
+	StateOpen = "open"
+
+	// StateInUse is the state label of the checked out connections
+	StateInUse = "in_use"
+)
+
+// NewPoolMonitor creates the MongoDB pool monitor that records the pool stats
+func NewPoolMonitor() *event.PoolMonitor {
+	open := appmetrics.MongoDBPoolConnections.WithLabelValues(StateOpen)
+	inUse := appmetrics.MongoDBPoolConnections.WithLabelValues(StateInUse)
+
+	return &event.PoolMonitor{
+		Event: func(poolEvent *event.PoolEvent) {
+			appmetrics.MongoDBPoolEventsTotal.WithLabelValues(poolEvent.Type).Inc()
+
+			switch poolEvent.Type {
+			case event.ConnectionCreated:
+				open.Inc()
+			case event.ConnectionClosed:
+				open.Dec()
+			case event.GetSucceeded:
+				inUse.Inc()
+			case event.ConnectionReturned:
+				inUse.Dec()
+			}
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package mongodb
+
+import (
+	"context"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CreateTransaction creates a new transaction bound to the given context, so its cancellation and deadline
+// reach every query of the transaction
+func CreateTransaction(
+	ctx context.Context,
+	client *mongo.Client,
+	queries func(sc mongo.SessionContext) error,
+) error {
+	// Create the session
+	clientSession, err := commonmongodb.CreateSession(client)
+	if err != nil {
+		return err
+	}
+
+	// End the session even if the context is already done
+	defer clientSession.EndSession(context.WithoutCancel(ctx))
+
+	// Create the transaction options
+	transactionOptions := commonmongodb.CreateTransactionOptions()
+
+	// Start the transaction
+	return mongo.WithSession(
+		ctx, clientSession, func(sc mongo.SessionContext) error {
+			if err = clientSession.StartTransaction(transactionOptions); err != nil {
+				return err
+			}
+
+			// Call the queries
+			if err = queries(sc); err != nil {
+				_ = clientSession.AbortTransaction(context.WithoutCancel(sc))
+				return err
+			}
+
+			return clientSession.CommitTransaction(sc)
+		},
+	)
+}
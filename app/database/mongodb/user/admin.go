@@ -0,0 +1,274 @@
+package user
+
+import (
+	"context"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	commonmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb/model/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+// adminUserProjection is the projection of the user fields shown to the support staff
+var adminUserProjection = bson.M{
+	"username":                1,
+	"first_name":              1,
+	"last_name":               1,
+	"birthdate":               1,
+	"joined_at":               1,
+	"deleted_at":              1,
+	"locked_at":               1,
+	"lock_reason":             1,
+	"password_reset_required": 1,
+}
+
+// FindAdminUser finds a user, including the deleted ones
+func (d *Database) FindAdminUser(
+	ctx context.Context,
+	filter interface{},
+) (*User, error) {
+	// Create the find options
+	findOptions := commonmongodb.PrepareFindOneOptions(adminUserProjection, nil)
+
+	// Find the user
+	user := &User{}
+	if err := d.GetCollection(UserCollection).FindOne(
+		ctx,
+		filter,
+		findOptions,
+	).Decode(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// FindAdminUserByUserId finds a user by the user ID, including the deleted ones
+func (d *Database) FindAdminUserByUserId(
+	ctx context.Context,
+	userId string,
+) (*User, error) {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+
+	return d.FindAdminUser(ctx, bson.M{"_id": *userObjectId})
+}
+
+// FindAdminUserByUsername finds a user by username, including the deleted ones
+func (d *Database) FindAdminUserByUsername(
+	ctx context.Context,
+	username string,
+) (*User, error) {
+	return d.FindAdminUser(ctx, bson.M{"username": username})
+}
+
+// FindAdminUserByEmail finds the user the given email is currently assigned to, including the deleted ones
+func (d *Database) FindAdminUserByEmail(
+	ctx context.Context,
+	email string,
+) (*User, error) {
+	// Find the most recently assigned active email
+	userEmail, err := d.FindUserEmail(
+		ctx,
+		bson.M{
+			"email":      email,
+			"revoked_at": bson.M{"$exists": false},
+		},
+		bson.M{"user_id": 1},
+		bson.M{"assigned_at": -1},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.FindAdminUser(ctx, bson.M{"_id": userEmail.UserID})
+}
+
+// FindAdminUserByPhoneNumber finds the user the given phone number is currently assigned to, including the
+// deleted ones
+func (d *Database) FindAdminUserByPhoneNumber(
+	ctx context.Context,
+	phoneNumber string,
+) (*User, error) {
+	// Find the most recently assigned active phone number
+	userPhoneNumber, err := d.FindUserPhoneNumber(
+		ctx,
+		bson.M{
+			"phone_number": phoneNumber,
+			"revoked_at":   bson.M{"$exists": false},
+		},
+		bson.M{"user_id": 1},
+		bson.M{"assigned_at": -1},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.FindAdminUser(ctx, bson.M{"_id": userPhoneNumber.UserID})
+}
+
+// findUserHistory finds every document of the given collection assigned to the user, from the oldest to the newest
+func findUserHistory[T any](
+	ctx context.Context,
+	collection *mongo.Collection,
+	userId string,
+) (history []*T, err error) {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the find options
+	findOptions := commonmongodb.PrepareFindOptions(nil, bson.M{"assigned_at": 1}, 0, 0)
+
+	// Find the user's documents
+	cur, err := collection.Find(ctx, bson.M{"user_id": *userObjectId}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	// Iterate through the cursor
+	for cur.Next(ctx) {
+		var document T
+		if err = cur.Decode(&document); err != nil {
+			return nil, err
+		}
+		history = append(history, &document)
+	}
+
+	return history, cur.Err()
+}
+
+// GetUserEmailHistory gets every email assigned to the user, including the revoked ones
+func (d *Database) GetUserEmailHistory(
+	ctx context.Context,
+	userId string,
+) ([]*commonmongodbuser.UserEmail, error) {
+	return findUserHistory[commonmongodbuser.UserEmail](
+		ctx,
+		d.GetCollection(UserEmailCollection),
+		userId,
+	)
+}
+
+// GetUserPhoneNumberHistory gets every phone number assigned to the user, including the revoked ones
+func (d *Database) GetUserPhoneNumberHistory(
+	ctx context.Context,
+	userId string,
+) ([]*commonmongodbuser.UserPhoneNumber, error) {
+	return findUserHistory[commonmongodbuser.UserPhoneNumber](
+		ctx,
+		d.GetCollection(UserPhoneNumberCollection),
+		userId,
+	)
+}
+
+// GetUserUsernameHistory gets every username assigned to the user
+func (d *Database) GetUserUsernameHistory(
+	ctx context.Context,
+	userId string,
+) ([]*commonmongodbuser.UserUsernameLog, error) {
+	return findUserHistory[commonmongodbuser.UserUsernameLog](
+		ctx,
+		d.GetCollection(UserUsernameLogCollection),
+		userId,
+	)
+}
+
+// updateAdminUser updates a user by the user ID, including the deleted ones, and returns mongo.ErrNoDocuments
+// if no user matches the filter
+func (d *Database) updateAdminUser(
+	ctx context.Context,
+	userId string,
+	filter bson.M,
+	update bson.M,
+) error {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	filter["_id"] = *userObjectId
+
+	// Update the user and bump its version
+	update["$inc"] = bson.M{"version": 1}
+	result, err := d.GetCollection(UserCollection).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// LockUser locks the user account, so the user can't log in
+func (d *Database) LockUser(
+	ctx context.Context,
+	userId string,
+	reason string,
+) error {
+	return d.updateAdminUser(
+		ctx,
+		userId,
+		bson.M{},
+		bson.M{"$set": bson.M{"locked_at": time.Now(), "lock_reason": reason}},
+	)
+}
+
+// UnlockUser unlocks the user account
+func (d *Database) UnlockUser(
+	ctx context.Context,
+	userId string,
+) error {
+	return d.updateAdminUser(
+		ctx,
+		userId,
+		bson.M{},
+		bson.M{"$unset": bson.M{"locked_at": "", "lock_reason": ""}},
+	)
+}
+
+// RequirePasswordReset requires the user to reset the password before logging in again
+func (d *Database) RequirePasswordReset(
+	ctx context.Context,
+	userId string,
+) error {
+	return d.updateAdminUser(
+		ctx,
+		userId,
+		bson.M{},
+		bson.M{"$set": bson.M{"password_reset_required": true}},
+	)
+}
+
+// RestoreUser restores a deleted user, and returns mongo.ErrNoDocuments if the user is not deleted
+func (d *Database) RestoreUser(
+	ctx context.Context,
+	userId string,
+) error {
+	return d.updateAdminUser(
+		ctx,
+		userId,
+		bson.M{"deleted_at": bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{"deleted_at": ""}},
+	)
+}
+
+// InsertUserAdminAuditLog inserts a support staff action into the audit log
+func (d *Database) InsertUserAdminAuditLog(
+	ctx context.Context,
+	auditLog *UserAdminAuditLog,
+) error {
+	// Set the audit log ID and creation time
+	auditLog.ID = primitive.NewObjectID()
+	auditLog.CreatedAt = time.Now()
+
+	_, err := d.GetCollection(UserAdminAuditLogCollection).InsertOne(ctx, auditLog)
+	return err
+}
@@ -0,0 +1,115 @@
+package user
+
+import (
+	"context"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FindUsers finds the users that match the filter, excluding the deleted ones
+func (d *Database) FindUsers(
+	ctx context.Context,
+	filter interface{},
+	projection interface{},
+) (users []*User, err error) {
+	// Add not deleted filter
+	filter = bson.M{
+		"$and": []interface{}{
+			filter,
+			bson.M{"deleted_at": bson.M{"$exists": false}},
+		},
+	}
+
+	// Find the users
+	cur, err := d.GetCollection(UserCollection).Find(
+		ctx,
+		filter,
+		commonmongodb.PrepareFindOptions(projection, nil, 0, 0),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	// Iterate through the cursor
+	for cur.Next(ctx) {
+		user := &User{}
+		if err = cur.Decode(user); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, cur.Err()
+}
+
+// GetUsernamesByUserIds gets the usernames by user ID in a single query, keyed by the given user IDs. The invalid or
+// not found user IDs are returned as missing
+func (d *Database) GetUsernamesByUserIds(
+	ctx context.Context,
+	userIds []string,
+) (usernames map[string]string, missing []string, err error) {
+	// Convert the user IDs to object IDs, keeping the given user IDs of each object ID, since a hex string can be
+	// written in upper or lower case
+	givenUserIds := make(map[primitive.ObjectID][]string)
+	var userObjectIds []primitive.ObjectID
+	for _, userId := range userIds {
+		userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+		if err != nil {
+			continue
+		}
+		if _, ok := givenUserIds[*userObjectId]; !ok {
+			userObjectIds = append(userObjectIds, *userObjectId)
+		}
+		givenUserIds[*userObjectId] = append(givenUserIds[*userObjectId], userId)
+	}
+
+	// Find the users
+	usernames = make(map[string]string)
+	if len(userObjectIds) > 0 {
+		users, err := d.FindUsers(ctx, bson.M{"_id": bson.M{"$in": userObjectIds}}, bson.M{"username": 1})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, user := range users {
+			for _, userId := range givenUserIds[user.ID] {
+				usernames[userId] = user.Username
+			}
+		}
+	}
+
+	return usernames, missingKeys(userIds, usernames), nil
+}
+
+// GetUserIdsByUsernames gets the user IDs by username in a single query, the not found usernames are returned as
+// missing
+func (d *Database) GetUserIdsByUsernames(
+	ctx context.Context,
+	usernames []string,
+) (userIds map[string]string, missing []string, err error) {
+	// Find the users
+	users, err := d.FindUsers(ctx, bson.M{"username": bson.M{"$in": usernames}}, bson.M{"username": 1})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userIds = make(map[string]string)
+	for _, user := range users {
+		userIds[user.Username] = user.ID.Hex()
+	}
+
+	return userIds, missingKeys(usernames, userIds), nil
+}
+
+// missingKeys returns the keys that are not in the found values, without duplicates
+func missingKeys(keys []string, found map[string]string) (missing []string) {
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if _, ok := found[key]; ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		missing = append(missing, key)
+	}
+	return missing
+}
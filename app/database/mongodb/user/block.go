@@ -0,0 +1,161 @@
+package user
+
+import (
+	"context"
+	"errors"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// getUserObjectIds converts the user IDs to object IDs
+func getUserObjectIds(userIds ...string) ([]primitive.ObjectID, error) {
+	userObjectIds := make([]primitive.ObjectID, len(userIds))
+	for i, userId := range userIds {
+		userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+		if err != nil {
+			return nil, err
+		}
+		userObjectIds[i] = *userObjectId
+	}
+	return userObjectIds, nil
+}
+
+// BlockUser blocks a user, and returns UserAlreadyBlockedError if the user was already blocked
+func (d *Database) BlockUser(
+	ctx context.Context,
+	blockerId string,
+	blockedId string,
+) error {
+	// Convert the user IDs to object IDs
+	userObjectIds, err := getUserObjectIds(blockerId, blockedId)
+	if err != nil {
+		return err
+	}
+
+	// Insert the block, the unique index rejects the duplicated pairs
+	_, err = d.GetCollection(UserBlockCollection).InsertOne(
+		ctx, &UserBlock{
+			ID:        primitive.NewObjectID(),
+			BlockerID: userObjectIds[0],
+			BlockedID: userObjectIds[1],
+			CreatedAt: time.Now(),
+		},
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return UserAlreadyBlockedError
+	}
+	return err
+}
+
+// UnblockUser unblocks a user, and returns mongo.ErrNoDocuments if the user was not blocked
+func (d *Database) UnblockUser(
+	ctx context.Context,
+	blockerId string,
+	blockedId string,
+) error {
+	// Convert the user IDs to object IDs
+	userObjectIds, err := getUserObjectIds(blockerId, blockedId)
+	if err != nil {
+		return err
+	}
+
+	// Delete the block
+	result, err := d.GetCollection(UserBlockCollection).DeleteOne(
+		ctx,
+		bson.M{"blocker_id": userObjectIds[0], "blocked_id": userObjectIds[1]},
+	)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// GetBlockedUsers gets the users blocked by the user, from the most recently blocked
+func (d *Database) GetBlockedUsers(
+	ctx context.Context,
+	blockerId string,
+) (blocks []*UserBlock, err error) {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(blockerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the find options
+	findOptions := commonmongodb.PrepareFindOptions(nil, bson.M{"created_at": -1}, 0, 0)
+
+	// Find the user's blocks
+	cur, err := d.GetCollection(UserBlockCollection).Find(ctx, bson.M{"blocker_id": userObjectIds[0]}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	// Iterate through the cursor
+	for cur.Next(ctx) {
+		block := &UserBlock{}
+		if err = cur.Decode(block); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, cur.Err()
+}
+
+// findUserBlock checks if a block matching the filter exists
+func (d *Database) findUserBlock(ctx context.Context, filter bson.M) (bool, error) {
+	err := d.GetCollection(UserBlockCollection).FindOne(
+		ctx,
+		filter,
+		options.FindOne().SetProjection(bson.M{"_id": 1}),
+	).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// HasBlocked checks if the blocker has blocked the other user
+func (d *Database) HasBlocked(
+	ctx context.Context,
+	blockerId string,
+	blockedId string,
+) (bool, error) {
+	// Convert the user IDs to object IDs
+	userObjectIds, err := getUserObjectIds(blockerId, blockedId)
+	if err != nil {
+		return false, err
+	}
+
+	return d.findUserBlock(ctx, bson.M{"blocker_id": userObjectIds[0], "blocked_id": userObjectIds[1]})
+}
+
+// IsBlocked checks if either user has blocked the other one
+func (d *Database) IsBlocked(
+	ctx context.Context,
+	userId string,
+	otherUserId string,
+) (bool, error) {
+	// Convert the user IDs to object IDs
+	userObjectIds, err := getUserObjectIds(userId, otherUserId)
+	if err != nil {
+		return false, err
+	}
+
+	return d.findUserBlock(
+		ctx, bson.M{
+			"$or": bson.A{
+				bson.M{"blocker_id": userObjectIds[0], "blocked_id": userObjectIds[1]},
+				bson.M{"blocker_id": userObjectIds[1], "blocked_id": userObjectIds[0]},
+			},
+		},
+	)
+}
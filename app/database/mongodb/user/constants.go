@@ -2,6 +2,9 @@ package user
 
 import (
 	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
@@ -23,20 +26,58 @@ var (
 		),
 	}
 
+	// userCollectionCompoundIndex is the compound indexes for the user collection, including the text index of the
+	// first and last name used by the search, which can't be declared through the field indexes
+	userCollectionCompoundIndex = []*commonmongodb.CompoundFieldIndex{
+		{
+			Model: &mongo.IndexModel{
+				Keys: bson.D{
+					{Key: "first_name", Value: "text"},
+					{Key: "last_name", Value: "text"},
+				},
+				Options: options.Index().SetName("name_text"),
+			},
+		},
+	}
+
 	// UserCollection is the users collection in MongoDB
 	UserCollection = commonmongodb.NewCollection(
 		"User",
 		&userCollectionSingleFieldIndex,
-		nil,
+		&userCollectionCompoundIndex,
 	)
 
+	// userEmailCollectionSingleFieldIndex is the single field indexes for the user email collection
+	userEmailCollectionSingleFieldIndex = []*commonmongodb.SingleFieldIndex{
+		commonmongodb.NewSingleFieldIndex(
+			commonmongodb.FieldIndex{
+				Name:  "email",
+				Order: commonmongodb.Ascending,
+			}, false,
+		),
+	}
+
 	// UserEmailCollection is the user emails collection in MongoDB
-	UserEmailCollection = commonmongodb.NewCollection("UserEmail", nil, nil)
+	UserEmailCollection = commonmongodb.NewCollection(
+		"UserEmail",
+		&userEmailCollectionSingleFieldIndex,
+		nil,
+	)
+
+	// userPhoneNumberCollectionSingleFieldIndex is the single field indexes for the user phone number collection
+	userPhoneNumberCollectionSingleFieldIndex = []*commonmongodb.SingleFieldIndex{
+		commonmongodb.NewSingleFieldIndex(
+			commonmongodb.FieldIndex{
+				Name:  "phone_number",
+				Order: commonmongodb.Ascending,
+			}, false,
+		),
+	}
 
 	// UserPhoneNumberCollection is the user phone numbers collection in MongoDB
 	UserPhoneNumberCollection = commonmongodb.NewCollection(
 		"UserPhoneNumber",
-		nil,
+		&userPhoneNumberCollectionSingleFieldIndex,
 		nil,
 	)
 
@@ -47,6 +88,184 @@ var (
 		nil,
 	)
 
+	// userAdminAuditLogCollectionCompoundIndex is the compound indexes for the user admin audit log collection
+	userAdminAuditLogCollectionCompoundIndex = []*commonmongodb.CompoundFieldIndex{
+		commonmongodb.NewCompoundFieldIndex(
+			[]*commonmongodb.FieldIndex{
+				commonmongodb.NewFieldIndex("user_id", commonmongodb.Ascending),
+				commonmongodb.NewFieldIndex("created_at", commonmongodb.Descending),
+			}, false,
+		),
+		commonmongodb.NewCompoundFieldIndex(
+			[]*commonmongodb.FieldIndex{
+				commonmongodb.NewFieldIndex("admin_id", commonmongodb.Ascending),
+				commonmongodb.NewFieldIndex("created_at", commonmongodb.Descending),
+			}, false,
+		),
+	}
+
+	// UserAdminAuditLogCollection is the user admin audit log collection in MongoDB
+	UserAdminAuditLogCollection = commonmongodb.NewCollection(
+		"UserAdminAuditLog",
+		nil,
+		&userAdminAuditLogCollectionCompoundIndex,
+	)
+
+	// UserPreferencesCollection is the user preferences collection in MongoDB, keyed by the user ID
+	UserPreferencesCollection = commonmongodb.NewCollection(
+		"UserPreferences",
+		nil,
+		nil,
+	)
+
+	// userBlockCollectionCompoundIndex is the compound indexes for the user block collection, where each pair of
+	// users is unique
+	userBlockCollectionCompoundIndex = []*commonmongodb.CompoundFieldIndex{
+		commonmongodb.NewCompoundFieldIndex(
+			[]*commonmongodb.FieldIndex{
+				commonmongodb.NewFieldIndex("blocker_id", commonmongodb.Ascending),
+				commonmongodb.NewFieldIndex("blocked_id", commonmongodb.Ascending),
+			}, true,
+		),
+		commonmongodb.NewCompoundFieldIndex(
+			[]*commonmongodb.FieldIndex{
+				commonmongodb.NewFieldIndex("blocked_id", commonmongodb.Ascending),
+				commonmongodb.NewFieldIndex("blocker_id", commonmongodb.Ascending),
+			}, false,
+		),
+	}
+
+	// UserBlockCollection is the user block collection in MongoDB
+	UserBlockCollection = commonmongodb.NewCollection(
+		"UserBlock",
+		nil,
+		&userBlockCollectionCompoundIndex,
+	)
+
+	// UserTOTPCollection is the user TOTP second factor collection in MongoDB, keyed by the user ID
+	UserTOTPCollection = commonmongodb.NewCollection(
+		"UserTOTP",
+		nil,
+		nil,
+	)
+
+	// userPasskeyCredentialCollectionSingleFieldIndex is the single field indexes for the user passkey credential
+	// collection
+	userPasskeyCredentialCollectionSingleFieldIndex = []*commonmongodb.SingleFieldIndex{
+		commonmongodb.NewSingleFieldIndex(
+			commonmongodb.FieldIndex{
+				Name:  "credential_id",
+				Order: commonmongodb.Ascending,
+			}, true,
+		),
+		commonmongodb.NewSingleFieldIndex(
+			commonmongodb.FieldIndex{
+				Name:  "user_id",
+				Order: commonmongodb.Ascending,
+			}, false,
+		),
+	}
+
+	// UserPasskeyCredentialCollection is the user passkey credential collection in MongoDB
+	UserPasskeyCredentialCollection = commonmongodb.NewCollection(
+		"UserPasskeyCredential",
+		&userPasskeyCredentialCollectionSingleFieldIndex,
+		nil,
+	)
+
+	// userPasskeySessionCollectionCompoundIndex is the compound indexes for the user passkey session collection,
+	// including the TTL index that removes the expired ceremonies, which can't be declared through the field indexes
+	userPasskeySessionCollectionCompoundIndex = []*commonmongodb.CompoundFieldIndex{
+		{
+			Model: &mongo.IndexModel{
+				Keys:    bson.D{{Key: "expires_at", Value: 1}},
+				Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+			},
+		},
+	}
+
+	// UserPasskeySessionCollection is the user passkey ceremony session collection in MongoDB
+	UserPasskeySessionCollection = commonmongodb.NewCollection(
+		"UserPasskeySession",
+		nil,
+		&userPasskeySessionCollectionCompoundIndex,
+	)
+
+	// userIdempotencyKeyCollectionCompoundIndex is the compound indexes for the user idempotency key collection,
+	// including the TTL index that removes the expired keys
+	userIdempotencyKeyCollectionCompoundIndex = []*commonmongodb.CompoundFieldIndex{
+		{
+			Model: &mongo.IndexModel{
+				Keys:    bson.D{{Key: "expires_at", Value: 1}},
+				Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+			},
+		},
+	}
+
+	// UserIdempotencyKeyCollection is the user idempotency key collection in MongoDB
+	UserIdempotencyKeyCollection = commonmongodb.NewCollection(
+		"UserIdempotencyKey",
+		nil,
+		&userIdempotencyKeyCollectionCompoundIndex,
+	)
+
+	// userResetPasswordCollectionSingleFieldIndex is the single field indexes for the user password reset collection
+	userResetPasswordCollectionSingleFieldIndex = []*commonmongodb.SingleFieldIndex{
+		commonmongodb.NewSingleFieldIndex(
+			commonmongodb.FieldIndex{
+				Name:  "uuid",
+				Order: commonmongodb.Ascending,
+			}, true,
+		),
+		commonmongodb.NewSingleFieldIndex(
+			commonmongodb.FieldIndex{
+				Name:  "user_id",
+				Order: commonmongodb.Ascending,
+			}, false,
+		),
+	}
+
+	// UserResetPasswordCollection is the user password reset collection in MongoDB, which stores the hashes of the
+	// tokens sent by email
+	UserResetPasswordCollection = commonmongodb.NewCollection(
+		"UserResetPassword",
+		&userResetPasswordCollectionSingleFieldIndex,
+		nil,
+	)
+
+	// userEmailVerificationCollectionSingleFieldIndex is the single field indexes for the user email verification
+	// collection
+	userEmailVerificationCollectionSingleFieldIndex = []*commonmongodb.SingleFieldIndex{
+		commonmongodb.NewSingleFieldIndex(
+			commonmongodb.FieldIndex{
+				Name:  "uuid",
+				Order: commonmongodb.Ascending,
+			}, true,
+		),
+		commonmongodb.NewSingleFieldIndex(
+			commonmongodb.FieldIndex{
+				Name:  "user_email_id",
+				Order: commonmongodb.Ascending,
+			}, false,
+		),
+	}
+
+	// UserEmailVerificationCollection is the user email verification collection in MongoDB, which stores the hashes
+	// of the tokens sent by email
+	UserEmailVerificationCollection = commonmongodb.NewCollection(
+		"UserEmailVerification",
+		&userEmailVerificationCollectionSingleFieldIndex,
+		nil,
+	)
+
+	// UserMigrationCollection is the user migration collection in MongoDB, which records the data migrations that
+	// were already applied
+	UserMigrationCollection = commonmongodb.NewCollection(
+		"UserMigration",
+		nil,
+		nil,
+	)
+
 	// UserHashedPasswordLogCollection is the user hashed password log collection in MongoDB
 	UserHashedPasswordLogCollection = commonmongodb.NewCollection(
 		"UserHashedPasswordLog",
@@ -5,4 +5,11 @@ import "errors"
 var (
 	NilDatabaseError        = errors.New("user database cannot be nil")
 	EmailAlreadyExistsError = errors.New("user email already exists")
+	InvalidPageTokenError   = errors.New("invalid page token")
+	UserAlreadyBlockedError = errors.New("user already blocked")
+	TOTPAlreadyEnabledError = errors.New("totp already enabled")
+	TOTPLockedError         = errors.New("totp locked")
+	PasskeyRegisteredError  = errors.New("passkey already registered")
+	EmailNotVerifiedError   = errors.New("user email not verified")
+	VersionMismatchError    = errors.New("user version mismatch")
 )
@@ -0,0 +1,105 @@
+package user
+
+import (
+	"context"
+	appidempotency "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/idempotency"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+// ReserveIdempotencyKey stores the key of a request in progress until the lock expires, and returns
+// appidempotency.KeyExistsError with the existing record if the key was already used
+func (d *Database) ReserveIdempotencyKey(
+	ctx context.Context,
+	key string,
+	fingerprint string,
+	lockTTL time.Duration,
+) (*appidempotency.Record, error) {
+	currentTime := time.Now()
+	_, err := d.GetCollection(UserIdempotencyKeyCollection).InsertOne(
+		ctx, &UserIdempotencyKey{
+			ID:          key,
+			Fingerprint: fingerprint,
+			CreatedAt:   currentTime,
+			ExpiresAt:   currentTime.Add(lockTTL),
+		},
+	)
+	if err == nil {
+		return nil, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, err
+	}
+
+	// Reuse the key if it has expired, since the TTL index removes the expired keys periodically, which includes the
+	// keys of the requests that never completed
+	result, err := d.GetCollection(UserIdempotencyKeyCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": key, "expires_at": bson.M{"$lte": currentTime}},
+		bson.M{
+			"$set": bson.M{
+				"fingerprint": fingerprint,
+				"created_at":  currentTime,
+				"expires_at":  currentTime.Add(lockTTL),
+			},
+			"$unset": bson.M{"response": "", "completed_at": ""},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount > 0 {
+		return nil, nil
+	}
+
+	// Get the existing record
+	userIdempotencyKey := &UserIdempotencyKey{}
+	if err = d.GetCollection(UserIdempotencyKeyCollection).FindOne(
+		ctx,
+		bson.M{"_id": key},
+	).Decode(userIdempotencyKey); err != nil {
+		return nil, err
+	}
+
+	return &appidempotency.Record{
+		Key:         userIdempotencyKey.ID,
+		Fingerprint: userIdempotencyKey.Fingerprint,
+		Response:    userIdempotencyKey.Response,
+		Completed:   !userIdempotencyKey.CompletedAt.IsZero(),
+	}, appidempotency.KeyExistsError
+}
+
+// CompleteIdempotencyKey stores the response of the request, so it is replayed to the retries until it expires
+func (d *Database) CompleteIdempotencyKey(
+	ctx context.Context,
+	key string,
+	response []byte,
+	ttl time.Duration,
+) error {
+	currentTime := time.Now()
+	_, err := d.GetCollection(UserIdempotencyKeyCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": key},
+		bson.M{
+			"$set": bson.M{
+				"response":     response,
+				"completed_at": currentTime,
+				"expires_at":   currentTime.Add(ttl),
+			},
+		},
+	)
+	return err
+}
+
+// ReleaseIdempotencyKey removes the key of a request in progress, so it can be retried
+func (d *Database) ReleaseIdempotencyKey(
+	ctx context.Context,
+	key string,
+) error {
+	_, err := d.GetCollection(UserIdempotencyKeyCollection).DeleteOne(
+		ctx,
+		bson.M{"_id": key, "completed_at": bson.M{"$exists": false}},
+	)
+	return err
+}
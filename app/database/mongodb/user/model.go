@@ -0,0 +1,112 @@
+package user
+
+import (
+	commonmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb/model/user"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// User is the MongoDB user model with the account status fields managed by the support staff
+type User struct {
+	commonmongodbuser.User `bson:",inline"`
+	LockedAt               time.Time         `json:"locked_at,omitempty" bson:"locked_at,omitempty"`
+	LockReason             string            `json:"lock_reason,omitempty" bson:"lock_reason,omitempty"`
+	PasswordResetRequired  bool              `json:"password_reset_required,omitempty" bson:"password_reset_required,omitempty"`
+	ProfileVisibility      map[string]string `json:"profile_visibility,omitempty" bson:"profile_visibility,omitempty"`
+	Bio                    string            `json:"bio,omitempty" bson:"bio,omitempty"`
+	Location               string            `json:"location,omitempty" bson:"location,omitempty"`
+	Website                string            `json:"website,omitempty" bson:"website,omitempty"`
+	SocialLinks            []string          `json:"social_links,omitempty" bson:"social_links,omitempty"`
+	Pronouns               string            `json:"pronouns,omitempty" bson:"pronouns,omitempty"`
+	DisplayName            string            `json:"display_name,omitempty" bson:"display_name,omitempty"`
+	Version                int64             `json:"version" bson:"version"`
+}
+
+// UserAdminAuditLog is the MongoDB model of an action taken by the support staff
+type UserAdminAuditLog struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id"`
+	AdminID   string             `json:"admin_id" bson:"admin_id"`
+	Action    string             `json:"action" bson:"action"`
+	UserID    string             `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	Reason    string             `json:"reason,omitempty" bson:"reason,omitempty"`
+	Role      string             `json:"role,omitempty" bson:"role,omitempty"`
+	RequestID string             `json:"request_id,omitempty" bson:"request_id,omitempty"`
+	Code      string             `json:"code" bson:"code"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// UserNotificationPreferences is the MongoDB model of the user's notification channel opt-ins
+type UserNotificationPreferences struct {
+	Email bool `json:"email" bson:"email"`
+	SMS   bool `json:"sms" bson:"sms"`
+	Push  bool `json:"push" bson:"push"`
+}
+
+// UserPreferences is the MongoDB model of the user's preferences, whose ID is the user ID
+type UserPreferences struct {
+	ID            primitive.ObjectID          `json:"id" bson:"_id"`
+	Locale        string                      `json:"locale" bson:"locale"`
+	Timezone      string                      `json:"timezone" bson:"timezone"`
+	Currency      string                      `json:"currency" bson:"currency"`
+	Notifications UserNotificationPreferences `json:"notifications" bson:"notifications"`
+	UpdatedAt     time.Time                   `json:"updated_at" bson:"updated_at"`
+}
+
+// UserBlock is the MongoDB model of a user blocked by another user
+type UserBlock struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id"`
+	BlockerID primitive.ObjectID `json:"blocker_id" bson:"blocker_id"`
+	BlockedID primitive.ObjectID `json:"blocked_id" bson:"blocked_id"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// UserTOTP is the MongoDB model of the user's TOTP second factor, whose ID is the user ID. It is pending until the
+// user confirms it with a code
+type UserTOTP struct {
+	ID             primitive.ObjectID `json:"id" bson:"_id"`
+	Secret         string             `json:"secret" bson:"secret"`
+	RecoveryCodes  []string           `json:"recovery_codes,omitempty" bson:"recovery_codes,omitempty"`
+	LastUsedStep   int64              `json:"last_used_step,omitempty" bson:"last_used_step,omitempty"`
+	FailedAttempts int                `json:"failed_attempts,omitempty" bson:"failed_attempts,omitempty"`
+	LockedUntil    time.Time          `json:"locked_until,omitempty" bson:"locked_until,omitempty"`
+	CreatedAt      time.Time          `json:"created_at" bson:"created_at"`
+	ConfirmedAt    time.Time          `json:"confirmed_at,omitempty" bson:"confirmed_at,omitempty"`
+}
+
+// UserPasskeyCredential is the MongoDB model of a WebAuthn credential registered by the user
+type UserPasskeyCredential struct {
+	ID              primitive.ObjectID `json:"id" bson:"_id"`
+	UserID          primitive.ObjectID `json:"user_id" bson:"user_id"`
+	CredentialID    []byte             `json:"credential_id" bson:"credential_id"`
+	PublicKey       []byte             `json:"public_key" bson:"public_key"`
+	AttestationType string             `json:"attestation_type,omitempty" bson:"attestation_type,omitempty"`
+	AAGUID          []byte             `json:"aaguid,omitempty" bson:"aaguid,omitempty"`
+	SignCount       uint32             `json:"sign_count" bson:"sign_count"`
+	CloneWarning    bool               `json:"clone_warning,omitempty" bson:"clone_warning,omitempty"`
+	Transports      []string           `json:"transports,omitempty" bson:"transports,omitempty"`
+	BackupEligible  bool               `json:"backup_eligible,omitempty" bson:"backup_eligible,omitempty"`
+	BackupState     bool               `json:"backup_state,omitempty" bson:"backup_state,omitempty"`
+	Name            string             `json:"name" bson:"name"`
+	CreatedAt       time.Time          `json:"created_at" bson:"created_at"`
+	LastUsedAt      time.Time          `json:"last_used_at,omitempty" bson:"last_used_at,omitempty"`
+}
+
+// UserPasskeySession is the MongoDB model of a started WebAuthn ceremony, which is removed once it expires
+type UserPasskeySession struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id"`
+	UserID    primitive.ObjectID `json:"user_id" bson:"user_id"`
+	Ceremony  string             `json:"ceremony" bson:"ceremony"`
+	Data      []byte             `json:"data" bson:"data"`
+	ExpiresAt time.Time          `json:"expires_at" bson:"expires_at"`
+}
+
+// UserIdempotencyKey is the MongoDB model of a request sent with an idempotency key, which has no completion time
+// while the request is in progress
+type UserIdempotencyKey struct {
+	ID          string    `json:"id" bson:"_id"`
+	Fingerprint string    `json:"fingerprint" bson:"fingerprint"`
+	Response    []byte    `json:"response,omitempty" bson:"response,omitempty"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at"`
+	CompletedAt time.Time `json:"completed_at,omitempty" bson:"completed_at,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at" bson:"expires_at"`
+}
@@ -0,0 +1,178 @@
+package user
+
+import (
+	"context"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+// InsertUserPasskeySession stores a started ceremony until it expires, and returns its ID
+func (d *Database) InsertUserPasskeySession(
+	ctx context.Context,
+	userId string,
+	ceremony string,
+	data []byte,
+	ttl time.Duration,
+) (sessionId string, err error) {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return "", err
+	}
+
+	session := &UserPasskeySession{
+		ID:        primitive.NewObjectID(),
+		UserID:    userObjectIds[0],
+		Ceremony:  ceremony,
+		Data:      data,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if _, err = d.GetCollection(UserPasskeySessionCollection).InsertOne(ctx, session); err != nil {
+		return "", err
+	}
+	return session.ID.Hex(), nil
+}
+
+// ConsumeUserPasskeySession removes a started ceremony so it can only be finished once, and returns
+// mongo.ErrNoDocuments if it doesn't exist or has expired
+func (d *Database) ConsumeUserPasskeySession(
+	ctx context.Context,
+	sessionId string,
+	ceremony string,
+) (*UserPasskeySession, error) {
+	// Convert the session ID to an object ID
+	sessionObjectId, err := commonmongodb.GetObjectIdFromString(sessionId)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+
+	// The TTL index removes the expired sessions periodically, so they are also filtered
+	session := &UserPasskeySession{}
+	if err = d.GetCollection(UserPasskeySessionCollection).FindOneAndDelete(
+		ctx,
+		bson.M{
+			"_id":        *sessionObjectId,
+			"ceremony":   ceremony,
+			"expires_at": bson.M{"$gt": time.Now()},
+		},
+	).Decode(session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// GetUserPasskeyCredentials gets the passkeys registered by the user, from the oldest to the newest
+func (d *Database) GetUserPasskeyCredentials(
+	ctx context.Context,
+	userId string,
+) (credentials []*UserPasskeyCredential, err error) {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the find options
+	findOptions := commonmongodb.PrepareFindOptions(nil, bson.M{"created_at": 1}, 0, 0)
+
+	// Find the user's passkeys
+	cur, err := d.GetCollection(UserPasskeyCredentialCollection).Find(
+		ctx,
+		bson.M{"user_id": userObjectIds[0]},
+		findOptions,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	// Iterate through the cursor
+	for cur.Next(ctx) {
+		credential := &UserPasskeyCredential{}
+		if err = cur.Decode(credential); err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, credential)
+	}
+
+	return credentials, cur.Err()
+}
+
+// InsertUserPasskeyCredential stores a registered passkey, and returns PasskeyRegisteredError if the credential ID
+// is already registered
+func (d *Database) InsertUserPasskeyCredential(
+	ctx context.Context,
+	credential *UserPasskeyCredential,
+) error {
+	// Set the passkey ID and creation time
+	credential.ID = primitive.NewObjectID()
+	credential.CreatedAt = time.Now()
+
+	_, err := d.GetCollection(UserPasskeyCredentialCollection).InsertOne(ctx, credential)
+	if mongo.IsDuplicateKeyError(err) {
+		return PasskeyRegisteredError
+	}
+	return err
+}
+
+// UpdateUserPasskeyCredentialUsage stores the sign count of a passkey after an assertion
+func (d *Database) UpdateUserPasskeyCredentialUsage(
+	ctx context.Context,
+	credentialId []byte,
+	signCount uint32,
+) error {
+	_, err := d.GetCollection(UserPasskeyCredentialCollection).UpdateOne(
+		ctx,
+		bson.M{"credential_id": credentialId},
+		bson.M{
+			"$set": bson.M{
+				"sign_count":   signCount,
+				"last_used_at": time.Now(),
+			},
+		},
+	)
+	return err
+}
+
+// FlagUserPasskeyCredentialClone flags a passkey whose sign count did not increase, so it is rejected until the user
+// revokes it
+func (d *Database) FlagUserPasskeyCredentialClone(
+	ctx context.Context,
+	credentialId []byte,
+) error {
+	_, err := d.GetCollection(UserPasskeyCredentialCollection).UpdateOne(
+		ctx,
+		bson.M{"credential_id": credentialId},
+		bson.M{"$set": bson.M{"clone_warning": true}},
+	)
+	return err
+}
+
+// DeleteUserPasskeyCredential revokes a passkey of the user, and returns mongo.ErrNoDocuments if the user has no
+// passkey with the given ID
+func (d *Database) DeleteUserPasskeyCredential(
+	ctx context.Context,
+	userId string,
+	passkeyId string,
+) error {
+	// Convert the user and passkey IDs to object IDs
+	objectIds, err := getUserObjectIds(userId, passkeyId)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+
+	result, err := d.GetCollection(UserPasskeyCredentialCollection).DeleteOne(
+		ctx,
+		bson.M{"_id": objectIds[1], "user_id": objectIds[0]},
+	)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
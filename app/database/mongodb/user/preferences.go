@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"errors"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	apppreferences "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/preferences"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// NewUserPreferences creates the default preferences of the user
+func NewUserPreferences(userId string) (*UserPreferences, error) {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserPreferences{
+		ID:       *userObjectId,
+		Locale:   apppreferences.DefaultLocale,
+		Timezone: apppreferences.DefaultTimezone,
+		Currency: apppreferences.DefaultCurrency,
+		Notifications: UserNotificationPreferences{
+			Email: apppreferences.DefaultEmailOptIn,
+			SMS:   apppreferences.DefaultSMSOptIn,
+			Push:  apppreferences.DefaultPushOptIn,
+		},
+	}, nil
+}
+
+// GetUserPreferences gets the user's preferences, or the default ones if the user has not set any
+func (d *Database) GetUserPreferences(
+	ctx context.Context,
+	userId string,
+) (*UserPreferences, error) {
+	// Get the default preferences, which also validates the user ID
+	preferences, err := NewUserPreferences(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the user's preferences
+	err = d.GetCollection(UserPreferencesCollection).FindOne(
+		ctx,
+		bson.M{"_id": preferences.ID},
+	).Decode(preferences)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// UpdateUserPreferences sets the given preference keys, creating the preferences with the default values of the
+// other keys if the user has not set any, and returns the updated preferences
+func (d *Database) UpdateUserPreferences(
+	ctx context.Context,
+	userId string,
+	update map[string]interface{},
+) (*UserPreferences, error) {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the given keys, and the default values of the other keys when the preferences are created
+	set := bson.M{"updated_at": time.Now()}
+	setOnInsert := bson.M{}
+	for key, value := range apppreferences.Defaults {
+		if updatedValue, ok := update[key]; ok {
+			set[key] = updatedValue
+		} else {
+			setOnInsert[key] = value
+		}
+	}
+
+	updateDocument := bson.M{"$set": set}
+	if len(setOnInsert) > 0 {
+		updateDocument["$setOnInsert"] = setOnInsert
+	}
+
+	// Update the user's preferences
+	preferences := &UserPreferences{}
+	err = d.GetCollection(UserPreferencesCollection).FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": *userObjectId},
+		updateDocument,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(preferences)
+	if err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
@@ -0,0 +1,127 @@
+package user
+
+import (
+	"context"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	commonmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb/model/user"
+	appmongodb "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// GetUserVerifiedPrimaryEmail gets the user's primary email, it returns mongo.ErrNoDocuments if it is not verified
+func (d *Database) GetUserVerifiedPrimaryEmail(
+	ctx context.Context,
+	userId string,
+) (email string, err error) {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the user's verified primary email
+	userEmail, err := d.FindUserEmail(
+		ctx,
+		bson.M{
+			"user_id":     *userObjectId,
+			"is_primary":  true,
+			"verified_at": bson.M{"$exists": true},
+			"revoked_at":  bson.M{"$exists": false},
+		},
+		bson.M{"email": 1},
+		nil,
+	)
+	if err != nil {
+		return "", err
+	}
+	return userEmail.Email, nil
+}
+
+// CreateUserResetPassword revokes the user's pending password resets and creates a new one with the hashed token
+func (d *Database) CreateUserResetPassword(
+	ctx context.Context,
+	userId string,
+	hashedToken string,
+	ttl time.Duration,
+) error {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return err
+	}
+
+	// Run the transaction
+	return appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
+			currentTime := time.Now()
+
+			// Revoke the pending password resets, so only the last link sent can be used
+			if _, err = d.GetCollection(UserResetPasswordCollection).UpdateMany(
+				sc,
+				bson.M{
+					"user_id":    *userObjectId,
+					"used_at":    bson.M{"$exists": false},
+					"revoked_at": bson.M{"$exists": false},
+				},
+				bson.M{"$set": bson.M{"revoked_at": currentTime}},
+			); err != nil {
+				return err
+			}
+
+			// Insert the new password reset
+			_, err = d.GetCollection(UserResetPasswordCollection).InsertOne(
+				sc, commonmongodbuser.UserResetPassword{
+					ID:        primitive.NewObjectID(),
+					UserID:    *userObjectId,
+					UUID:      hashedToken,
+					CreatedAt: currentTime,
+					ExpiresAt: currentTime.Add(ttl),
+				},
+			)
+			return err
+		},
+	)
+}
+
+// ResetUserPassword uses the password reset of the hashed token to set the user's password, and returns the user ID.
+// It returns mongo.ErrNoDocuments if the password reset doesn't exist, was already used or revoked, or has expired
+func (d *Database) ResetUserPassword(
+	ctx context.Context,
+	hashedToken string,
+	hashedPassword string,
+) (userId string, err error) {
+	// Run the transaction
+	err = appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
+			currentTime := time.Now()
+
+			// Mark the password reset as used
+			userResetPassword := &commonmongodbuser.UserResetPassword{}
+			if err = d.GetCollection(UserResetPasswordCollection).FindOneAndUpdate(
+				sc,
+				bson.M{
+					"uuid":       hashedToken,
+					"expires_at": bson.M{"$gt": currentTime},
+					"used_at":    bson.M{"$exists": false},
+					"revoked_at": bson.M{"$exists": false},
+				},
+				bson.M{"$set": bson.M{"used_at": currentTime}},
+				options.FindOneAndUpdate().SetProjection(bson.M{"user_id": 1}),
+			).Decode(userResetPassword); err != nil {
+				return err
+			}
+
+			// Update the user password
+			userId = userResetPassword.UserID.Hex()
+			return d.updateUserPassword(sc, &userResetPassword.UserID, hashedPassword)
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return userId, nil
+}
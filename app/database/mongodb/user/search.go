@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	appvisibility "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/visibility"
+	"go.mongodb.org/mongo-driver/bson"
+	"regexp"
+)
+
+// searchUserProjection is the projection of the user fields returned by the search
+var searchUserProjection = bson.M{
+	"username":           1,
+	"first_name":         1,
+	"last_name":          1,
+	"profile_visibility": 1,
+}
+
+// EncodeSearchUsersCursor encodes the username of the last user of a page as the token of the next page
+func EncodeSearchUsersCursor(username string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(username))
+}
+
+// DecodeSearchUsersCursor decodes the token of a page to the username of the last user of the previous page
+func DecodeSearchUsersCursor(pageToken string) (string, error) {
+	username, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil || len(username) == 0 {
+		return "", InvalidPageTokenError
+	}
+	return string(username), nil
+}
+
+// SearchUsers finds the users whose username starts with the query or whose first or last name match it, ordered
+// by username. The names only match if they are visible to the caller. The users come after the given cursor, and
+// the next cursor is empty on the last page
+func (d *Database) SearchUsers(
+	ctx context.Context,
+	query string,
+	authenticated bool,
+	limit int64,
+	cursor string,
+) (users []*User, nextCursor string, err error) {
+	// Match the username prefix, which uses the username index, or the first and last name through the text index
+	// if the caller can see them
+	hidden := bson.M{"$nin": appvisibility.Hidden(authenticated)}
+	filter := bson.M{
+		"deleted_at": bson.M{"$exists": false},
+		"$or": bson.A{
+			bson.M{"username": bson.M{"$regex": "^" + regexp.QuoteMeta(query)}},
+			bson.M{
+				"$and": bson.A{
+					bson.M{"$text": bson.M{"$search": query}},
+					bson.M{
+						"profile_visibility." + appvisibility.FirstName: hidden,
+						"profile_visibility." + appvisibility.LastName:  hidden,
+					},
+				},
+			},
+		},
+	}
+
+	// Start after the last user of the previous page
+	if cursor != "" {
+		filter["username"] = bson.M{"$gt": cursor}
+	}
+
+	// Create the find options, with an extra user to check if there is a next page
+	findOptions := commonmongodb.PrepareFindOptions(searchUserProjection, bson.M{"username": 1}, limit+1, 0)
+
+	// Find the users
+	cur, err := d.GetCollection(UserCollection).Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, "", err
+	}
+	defer cur.Close(ctx)
+
+	// Iterate through the cursor
+	for cur.Next(ctx) {
+		user := &User{}
+		if err = cur.Decode(user); err != nil {
+			return nil, "", err
+		}
+		users = append(users, user)
+	}
+	if err = cur.Err(); err != nil {
+		return nil, "", err
+	}
+
+	// Check if there is a next page
+	if int64(len(users)) > limit {
+		users = users[:limit]
+		nextCursor = users[limit-1].Username
+	}
+	return users, nextCursor, nil
+}
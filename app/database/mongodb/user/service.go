@@ -6,9 +6,12 @@ import (
 	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
 	commonmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb/model/user"
 	pbauth "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/compiled/pixel_plaza/auth"
+	appcache "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/cache"
+	appmongodb "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"time"
 )
@@ -18,13 +21,15 @@ type Database struct {
 	collections *map[string]*commonmongodb.Collection
 	client      *mongo.Client
 	authClient  pbauth.AuthClient
+	cache       appcache.Cache
 }
 
-// NewDatabase creates a new MongoDB user database handler
+// NewDatabase creates a new MongoDB user database handler, the lookups are not cached if the cache is nil
 func NewDatabase(
 	client *mongo.Client,
 	databaseName string,
 	authClient pbauth.AuthClient,
+	cache appcache.Cache,
 ) (database *Database, err error) {
 	// Get the user service database
 	userServiceDb := client.Database(databaseName)
@@ -38,6 +43,16 @@ func NewDatabase(
 		UserPhoneNumberCollection,
 		UserUsernameLogCollection,
 		UserHashedPasswordLogCollection,
+		UserAdminAuditLogCollection,
+		UserPreferencesCollection,
+		UserBlockCollection,
+		UserTOTPCollection,
+		UserPasskeyCredentialCollection,
+		UserPasskeySessionCollection,
+		UserIdempotencyKeyCollection,
+		UserResetPasswordCollection,
+		UserEmailVerificationCollection,
+		UserMigrationCollection,
 	} {
 		// Create the collection
 		collections[collection.Name] = collection
@@ -48,7 +63,7 @@ func NewDatabase(
 
 	return &Database{
 		client: client, database: userServiceDb, collections: &collections,
-		authClient: authClient,
+		authClient: authClient, cache: cache,
 	}, nil
 }
 
@@ -202,13 +217,14 @@ func (d *Database) CreateUserPhoneNumber(
 
 // InsertUser inserts a user into the database
 func (d *Database) InsertUser(
-	user *commonmongodbuser.User,
+	ctx context.Context,
+	user *User,
 	userEmail *commonmongodbuser.UserEmail,
 	userPhoneNumber *commonmongodbuser.UserPhoneNumber,
 ) error {
 	// Run the transaction
-	err := commonmongodb.CreateTransaction(
-		d.client, func(sc mongo.SessionContext) error {
+	err := appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
 			// Insert user
 			if _, err := d.GetCollection(UserCollection).InsertOne(
 				sc,
@@ -253,7 +269,7 @@ func (d *Database) FindUser(
 	filter interface{},
 	projection interface{},
 	sort interface{},
-) (*commonmongodbuser.User, error) {
+) (*User, error) {
 	// Set the default projection
 	if projection == nil {
 		projection = bson.M{"_id": 1}
@@ -271,7 +287,7 @@ func (d *Database) FindUser(
 	}
 
 	// Initialize the user variable
-	user := &commonmongodbuser.User{}
+	user := &User{}
 
 	// Find the user
 	err := d.GetCollection(UserCollection).FindOne(
@@ -292,7 +308,7 @@ func (d *Database) FindUserByUsername(
 	username string,
 	projection interface{},
 	sort interface{},
-) (user *commonmongodbuser.User, err error) {
+) (user *User, err error) {
 	// Check if the username is empty
 	if username == "" {
 		return nil, mongo.ErrNoDocuments
@@ -308,7 +324,7 @@ func (d *Database) FindUserByUserId(
 	userId string,
 	projection interface{},
 	sort interface{},
-) (user *commonmongodbuser.User, err error) {
+) (user *User, err error) {
 	// Check if the user ID is empty
 	if userId == "" {
 		return nil, mongo.ErrNoDocuments
@@ -328,7 +344,7 @@ func (d *Database) FindUserByUserId(
 func (d *Database) GetUserHashedPassword(
 	ctx context.Context,
 	username string,
-) (user *commonmongodbuser.User, err error) {
+) (user *User, err error) {
 	// Check if the username is empty
 	if username == "" {
 		return nil, mongo.ErrNoDocuments
@@ -338,7 +354,13 @@ func (d *Database) GetUserHashedPassword(
 	return d.FindUserByUsername(
 		ctx,
 		username,
-		bson.M{"_id": 1, "hashed_password": 1, "uuid": 1},
+		bson.M{
+			"_id":                     1,
+			"hashed_password":         1,
+			"uuid":                    1,
+			"locked_at":               1,
+			"password_reset_required": 1,
+		},
 		nil,
 	)
 }
@@ -348,12 +370,20 @@ func (d *Database) GetUsernameByUserId(
 	ctx context.Context,
 	userId string,
 ) (username string, err error) {
-	// Find the user
-	user, err := d.FindUserByUserId(ctx, userId, bson.M{"username": 1}, nil)
+	// Find the user through the cache
+	cachedUsername, err := appcache.ReadThrough(
+		ctx, d.cache, appcache.Key(appcache.UsernameByUserId, userId), func() (*string, error) {
+			user, err := d.FindUserByUserId(ctx, userId, bson.M{"username": 1}, nil)
+			if err != nil {
+				return nil, err
+			}
+			return &user.Username, nil
+		},
+	)
 	if err != nil {
 		return "", err
 	}
-	return user.Username, nil
+	return *cachedUsername, nil
 }
 
 // GetUserIdByUsername gets the user ID by the username
@@ -361,12 +391,21 @@ func (d *Database) GetUserIdByUsername(
 	ctx context.Context,
 	username string,
 ) (userId string, err error) {
-	// Find the user
-	user, err := d.FindUserByUsername(ctx, username, bson.M{"_id": 1}, nil)
+	// Find the user through the cache
+	cachedUserId, err := appcache.ReadThrough(
+		ctx, d.cache, appcache.Key(appcache.UserIdByUsername, username), func() (*string, error) {
+			user, err := d.FindUserByUsername(ctx, username, bson.M{"_id": 1}, nil)
+			if err != nil {
+				return nil, err
+			}
+			userId := user.ID.Hex()
+			return &userId, nil
+		},
+	)
 	if err != nil {
 		return "", err
 	}
-	return user.ID.Hex(), nil
+	return *cachedUserId, nil
 }
 
 // UsernameExists checks if the username exists
@@ -382,7 +421,38 @@ func (d *Database) UsernameExists(
 	return user != nil, nil
 }
 
-// UpdateUserByUserId updates a user by the user ID
+// invalidateUser removes the cached lookups of the user and of the given usernames
+func (d *Database) invalidateUser(ctx context.Context, userId string, usernames ...string) {
+	if d.cache == nil {
+		return
+	}
+
+	keys := []string{appcache.Key(appcache.UsernameByUserId, userId)}
+	for _, username := range usernames {
+		keys = append(
+			keys,
+			appcache.Key(appcache.UserIdByUsername, username),
+			appcache.Key(appcache.ProfileByUsername, username),
+		)
+	}
+	d.cache.Delete(ctx, keys...)
+}
+
+// getUsernameForInvalidation gets the current username of the user from the database, bypassing the cache, to
+// invalidate its cached lookups
+func (d *Database) getUsernameForInvalidation(ctx context.Context, userId string) []string {
+	if d.cache == nil {
+		return nil
+	}
+
+	user, err := d.FindUserByUserId(ctx, userId, bson.M{"username": 1}, nil)
+	if err != nil {
+		return nil
+	}
+	return []string{user.Username}
+}
+
+// UpdateUserByUserId updates a user by the user ID, and bumps its version
 func (d *Database) UpdateUserByUserId(
 	ctx context.Context,
 	userId string,
@@ -401,31 +471,41 @@ func (d *Database) UpdateUserByUserId(
 	result, err = d.GetCollection(UserCollection).UpdateOne(
 		ctx,
 		filter,
-		bson.M{"$set": update},
+		bson.M{"$set": update, "$inc": bson.M{"version": 1}},
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// Invalidate the cached profile
+	d.invalidateUser(ctx, userId, d.getUsernameForInvalidation(ctx, userId)...)
+
 	return result, nil
 }
 
 // UpdateUserUsername updates the user username
-func (d *Database) UpdateUserUsername(userId string, username string) error {
+func (d *Database) UpdateUserUsername(
+	ctx context.Context,
+	userId string,
+	username string,
+) error {
 	// Convert the user ID to an object ID
 	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
 	if err != nil {
 		return err
 	}
 
+	// Get the previous username to invalidate its cached lookups
+	previousUsernames := d.getUsernameForInvalidation(ctx, userId)
+
 	// Run the transaction
-	err = commonmongodb.CreateTransaction(
-		d.client, func(sc mongo.SessionContext) error {
+	err = appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
 			// Update the user username
 			if _, err = d.GetCollection(UserCollection).UpdateOne(
 				sc,
 				bson.M{"_id": *userObjectId},
-				bson.M{"username": username},
+				bson.M{"$set": bson.M{"username": username}, "$inc": bson.M{"version": 1}},
 			); err != nil {
 				return err
 			}
@@ -435,12 +515,56 @@ func (d *Database) UpdateUserUsername(userId string, username string) error {
 			return err
 		},
 	)
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the cached lookups of the previous and the new username
+	d.invalidateUser(ctx, userId, append(previousUsernames, username)...)
+	return nil
+}
+
+// incrementUserVersion increments the version of the user, for the writes that don't update the user document. It
+// must run inside a transaction
+func (d *Database) incrementUserVersion(
+	sc mongo.SessionContext,
+	userObjectId *primitive.ObjectID,
+) error {
+	_, err := d.GetCollection(UserCollection).UpdateOne(
+		sc,
+		bson.M{"_id": *userObjectId},
+		bson.M{"$inc": bson.M{"version": 1}},
+	)
 	return err
 }
 
+// updateUserPassword sets the user's password, clears the forced password reset and logs the hashed password. It
+// must run inside a transaction
+func (d *Database) updateUserPassword(
+	sc mongo.SessionContext,
+	userObjectId *primitive.ObjectID,
+	hashedPassword string,
+) error {
+	// Update the user password and clear the forced password reset
+	if _, err := d.GetCollection(UserCollection).UpdateOne(
+		sc,
+		bson.M{"_id": *userObjectId},
+		bson.M{
+			"$set":   bson.M{"hashed_password": hashedPassword},
+			"$unset": bson.M{"password_reset_required": ""},
+			"$inc":   bson.M{"version": 1},
+		},
+	); err != nil {
+		return err
+	}
+
+	// Create a new user hashed password log
+	return d.CreateUserHashedPasswordLog(sc, userObjectId, hashedPassword)
+}
+
 // UpdateUserPassword updates the user password
 func (d *Database) UpdateUserPassword(
-	grpcCtx context.Context,
+	ctx context.Context,
 	userId string,
 	hashedPassword string,
 ) error {
@@ -451,29 +575,16 @@ func (d *Database) UpdateUserPassword(
 	}
 
 	// Run the transaction
-	err = commonmongodb.CreateTransaction(
-		d.client, func(sc mongo.SessionContext) error {
+	err = appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
 			// Update the user password
-			if _, err = d.GetCollection(UserCollection).UpdateOne(
-				sc,
-				bson.M{"_id": *userObjectId},
-				bson.M{"hashed_password": hashedPassword},
-			); err != nil {
-				return err
-			}
-
-			// Create a new user hashed password log
-			if err = d.CreateUserHashedPasswordLog(
-				sc,
-				userObjectId,
-				hashedPassword,
-			); err != nil {
+			if err = d.updateUserPassword(sc, userObjectId, hashedPassword); err != nil {
 				return err
 			}
 
 			// Revoke all user's refresh tokens
 			_, err = d.authClient.RevokeRefreshTokens(
-				grpcCtx,
+				ctx,
 				&emptypb.Empty{},
 			)
 
@@ -483,25 +594,93 @@ func (d *Database) UpdateUserPassword(
 	return err
 }
 
-// UpdateUserProfile updates a user
+// UpdateUserProfile sets and clears the given fields of the user's profile, and returns its new version. If the
+// expected version is set and doesn't match the current one, it returns VersionMismatchError
 func (d *Database) UpdateUserProfile(
 	ctx context.Context,
 	userId string,
-	update interface{},
-) (result *mongo.UpdateResult, err error) {
-	return d.UpdateUserByUserId(ctx, userId, update)
+	set bson.M,
+	unset bson.M,
+	expectedVersion *int64,
+) (version int64, err error) {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return 0, err
+	}
+
+	// Create the filter, where the users created before the version was added have no version
+	filter := bson.M{"_id": *userObjectId}
+	if expectedVersion != nil {
+		if *expectedVersion == 0 {
+			filter["version"] = bson.M{"$in": bson.A{0, nil}}
+		} else {
+			filter["version"] = *expectedVersion
+		}
+	}
+
+	// Create the update, since the operators can't be empty
+	update := bson.M{"$inc": bson.M{"version": 1}}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	// Update the user
+	user := &User{}
+	if err = d.GetCollection(UserCollection).FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		options.FindOneAndUpdate().
+			SetProjection(bson.M{"version": 1}).
+			SetReturnDocument(options.After),
+	).Decode(user); err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) || expectedVersion == nil {
+			return 0, err
+		}
+
+		// Check if the user exists, so the version is the one that didn't match
+		if _, err = d.FindUserByUserId(ctx, userId, bson.M{"_id": 1}, nil); err != nil {
+			return 0, err
+		}
+		return 0, VersionMismatchError
+	}
+
+	// Invalidate the cached profile
+	d.invalidateUser(ctx, userId, d.getUsernameForInvalidation(ctx, userId)...)
+
+	return user.Version, nil
 }
 
 // GetUserProfile gets the user's profile
 func (d *Database) GetUserProfile(
 	ctx context.Context,
 	username string,
-) (user *commonmongodbuser.User, err error) {
-	return d.FindUserByUsername(
-		ctx,
-		username,
-		bson.M{"first_name": 1, "last_name": 1, "birthdate": 1},
-		nil,
+) (user *User, err error) {
+	return appcache.ReadThrough(
+		ctx, d.cache, appcache.Key(appcache.ProfileByUsername, username), func() (*User, error) {
+			return d.FindUserByUsername(
+				ctx,
+				username,
+				bson.M{
+					"first_name":         1,
+					"last_name":          1,
+					"birthdate":          1,
+					"joined_at":          1,
+					"profile_visibility": 1,
+					"bio":                1,
+					"location":           1,
+					"website":            1,
+					"social_links":       1,
+					"pronouns":           1,
+					"display_name":       1,
+				},
+				nil,
+			)
+		},
 	)
 }
 
@@ -570,6 +749,7 @@ func (d *Database) GetUserPhoneNumber(
 
 // UpdateUserPhoneNumber updates the user's phone number
 func (d *Database) UpdateUserPhoneNumber(
+	ctx context.Context,
 	userId string,
 	phoneNumber string,
 ) error {
@@ -580,8 +760,8 @@ func (d *Database) UpdateUserPhoneNumber(
 	}
 
 	// Run the transaction
-	err = commonmongodb.CreateTransaction(
-		d.client, func(sc mongo.SessionContext) error {
+	err = appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
 			// Revoke the user's phone number
 			if _, err = d.GetCollection(UserPhoneNumberCollection).UpdateOne(
 				sc,
@@ -589,7 +769,7 @@ func (d *Database) UpdateUserPhoneNumber(
 					"user_id":    *userObjectId,
 					"revoked_at": bson.M{"$exists": false},
 				},
-				bson.M{"revoked_at": time.Now()},
+				bson.M{"$set": bson.M{"revoked_at": time.Now()}},
 			); err != nil {
 				return err
 			}
@@ -603,42 +783,51 @@ func (d *Database) UpdateUserPhoneNumber(
 				return err
 			}
 
-			return nil
+			return d.incrementUserVersion(sc, userObjectId)
 		},
 	)
 	return err
 }
 
 // DeleteUser deletes a user
-func (d *Database) DeleteUser(grpcCtx context.Context, userId string) error {
+func (d *Database) DeleteUser(ctx context.Context, userId string) error {
 	// Convert the user ID to an object ID
 	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
 	if err != nil {
 		return err
 	}
 
+	// Get the username to invalidate its cached lookups
+	usernames := d.getUsernameForInvalidation(ctx, userId)
+
 	// Run the transaction
-	err = commonmongodb.CreateTransaction(
-		d.client, func(sc mongo.SessionContext) error {
+	err = appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
 			// Update the user deleted at field
 			if _, err = d.GetCollection(UserCollection).UpdateOne(
 				sc,
 				bson.M{"_id": *userObjectId},
-				bson.M{"deleted_at": time.Now()},
+				bson.M{"$set": bson.M{"deleted_at": time.Now()}, "$inc": bson.M{"version": 1}},
 			); err != nil {
 				return err
 			}
 
 			// Revoke all user's refresh tokens
 			_, err = d.authClient.RevokeRefreshTokens(
-				grpcCtx,
+				ctx,
 				&emptypb.Empty{},
 			)
 
 			return nil
 		},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the cached lookups of the deleted user
+	d.invalidateUser(ctx, userId, usernames...)
+	return nil
 }
 
 // AddUserEmail adds an email to a user
@@ -654,11 +843,11 @@ func (d *Database) AddUserEmail(
 	}
 
 	// Run the transaction
-	err = commonmongodb.CreateTransaction(
-		d.client, func(sc mongo.SessionContext) error {
+	err = appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
 			// Check if the user email already exists
 			_, err = d.FindUserEmail(
-				ctx,
+				sc,
 				bson.M{
 					"user_id":    *userObjectId,
 					"email":      email,
@@ -672,15 +861,23 @@ func (d *Database) AddUserEmail(
 			}
 
 			// Create the new user email
-			err = d.CreateUserEmail(ctx, userObjectId, email)
-			return err
+			if err = d.CreateUserEmail(sc, userObjectId, email); err != nil {
+				return err
+			}
+
+			return d.incrementUserVersion(sc, userObjectId)
 		},
 	)
 	return err
 }
 
-// UpdateUserPrimaryEmail updates the user's primary email
-func (d *Database) UpdateUserPrimaryEmail(userId string, email string) error {
+// UpdateUserPrimaryEmail updates the user's primary email, and returns EmailNotVerifiedError if the email is still
+// pending verification
+func (d *Database) UpdateUserPrimaryEmail(
+	ctx context.Context,
+	userId string,
+	email string,
+) error {
 	// Convert the user ID to an object ID
 	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
 	if err != nil {
@@ -688,8 +885,23 @@ func (d *Database) UpdateUserPrimaryEmail(userId string, email string) error {
 	}
 
 	// Run the transaction
-	err = commonmongodb.CreateTransaction(
-		d.client, func(sc mongo.SessionContext) error {
+	err = appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
+			// Check if the new primary email exists and is verified
+			userEmail, err := d.FindUserEmailByEmail(
+				sc,
+				*userObjectId,
+				email,
+				bson.M{"verified_at": 1},
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+			if userEmail.VerifiedAt.IsZero() {
+				return EmailNotVerifiedError
+			}
+
 			// Update the current user's primary email as not primary
 			if _, err = d.GetCollection(UserEmailCollection).UpdateOne(
 				sc,
@@ -706,24 +918,21 @@ func (d *Database) UpdateUserPrimaryEmail(userId string, email string) error {
 			// Update the new user's primary email
 			if _, err = d.GetCollection(UserEmailCollection).UpdateOne(
 				sc,
-				bson.M{
-					"user_id":    *userObjectId,
-					"email":      email,
-					"revoked_at": bson.M{"$exists": false},
-				},
+				bson.M{"_id": userEmail.ID},
 				bson.M{"$set": bson.M{"is_primary": true}},
 			); err != nil {
 				return err
 			}
 
-			return nil
+			return d.incrementUserVersion(sc, userObjectId)
 		},
 	)
 	return err
 }
 
-// DeleteUserEmail deletes an email from a user
-func (d *Database) DeleteUserEmail(
+// VerifyUserEmail marks a user's email as verified, and returns mongo.ErrNoDocuments if the user has no pending
+// email with the given address
+func (d *Database) VerifyUserEmail(
 	ctx context.Context,
 	userId string,
 	email string,
@@ -734,18 +943,79 @@ func (d *Database) DeleteUserEmail(
 		return err
 	}
 
-	// Revoke the user's email
-	_, err = d.GetCollection(UserEmailCollection).UpdateOne(
-		ctx,
+	// Run the transaction
+	return appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
+			return d.verifyUserEmail(sc, userObjectId, email)
+		},
+	)
+}
+
+// verifyUserEmail marks a user's email as verified, and returns mongo.ErrNoDocuments if the user has no pending email
+// with the given address. It must run inside a transaction
+func (d *Database) verifyUserEmail(
+	sc mongo.SessionContext,
+	userObjectId *primitive.ObjectID,
+	email string,
+) error {
+	// Verify the user's email
+	result, err := d.GetCollection(UserEmailCollection).UpdateOne(
+		sc,
 		bson.M{
-			"user_id":    *userObjectId,
-			"email":      email,
-			"is_primary": false,
-			"revoked_at": bson.M{"$exists": false},
+			"user_id":     *userObjectId,
+			"email":       email,
+			"verified_at": bson.M{"$exists": false},
+			"revoked_at":  bson.M{"$exists": false},
+		},
+		bson.M{"$set": bson.M{"verified_at": time.Now()}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return d.incrementUserVersion(sc, userObjectId)
+}
+
+// DeleteUserEmail deletes an email from a user, and returns mongo.ErrNoDocuments if the user has no active email with
+// the given address or it is the primary email
+func (d *Database) DeleteUserEmail(
+	ctx context.Context,
+	userId string,
+	email string,
+) error {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return err
+	}
+
+	// Run the transaction
+	return appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
+			// Revoke the user's email
+			result, err := d.GetCollection(UserEmailCollection).UpdateOne(
+				sc,
+				bson.M{
+					"user_id":    *userObjectId,
+					"email":      email,
+					"is_primary": false,
+					"revoked_at": bson.M{"$exists": false},
+				},
+				bson.M{"$set": bson.M{"revoked_at": time.Now()}},
+			)
+			if err != nil {
+				return err
+			}
+			if result.MatchedCount == 0 {
+				return mongo.ErrNoDocuments
+			}
+
+			return d.incrementUserVersion(sc, userObjectId)
 		},
-		bson.M{"revoked_at": time.Now()},
 	)
-	return err
 }
 
 // FindUserEmail finds a user's email
@@ -894,24 +1164,18 @@ func (d *Database) FindUserActiveEmails(
 	return emails, nil
 }
 
-// GetUserActiveEmails gets the user's active emails
+// GetUserActiveEmails gets the user's active emails with their verification state, starting with the primary email
+// and then from the oldest to the newest
 func (d *Database) GetUserActiveEmails(
 	ctx context.Context,
 	userId string,
-) (userActiveEmails []string, err error) {
-	// Find the user's active emails
-	var emails []*commonmongodbuser.UserEmail
-	emails, err = d.FindUserActiveEmails(ctx, userId, bson.M{"email": 1}, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	// Iterate through the emails
-	for _, email := range emails {
-		userActiveEmails = append(userActiveEmails, email.Email)
-	}
-
-	return userActiveEmails, nil
+) (userActiveEmails []*commonmongodbuser.UserEmail, err error) {
+	return d.FindUserActiveEmails(
+		ctx,
+		userId,
+		bson.M{"email": 1, "is_primary": 1, "assigned_at": 1, "verified_at": 1},
+		bson.D{{Key: "is_primary", Value: -1}, {Key: "assigned_at", Value: 1}},
+	)
 }
 
 // UserEmailExists checks if the user's email exists
@@ -938,32 +1202,52 @@ func (d *Database) UserEmailExists(
 }
 
 // GetMyProfile gets the user's profile
-func (d *Database) GetMyProfile(userId string) (
-	user *commonmongodbuser.User,
+func (d *Database) GetMyProfile(ctx context.Context, userId string) (
+	user *User,
 	userActiveEmails *[]string,
 	userPhoneNumber string,
 	err error,
 ) {
 	// Run the transaction
 	var activeEmails []string
-	err = commonmongodb.CreateTransaction(
-		d.client, func(sc mongo.SessionContext) error {
+	err = appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
 			// Get the full user profile
 			user, err = d.FindUserByUserId(
 				sc, userId, bson.M{
-					"username":   1,
-					"first_name": 1,
-					"last_name":  1,
-					"birthdate":  1,
-					"joined_at":  1,
+					"username":     1,
+					"first_name":   1,
+					"last_name":    1,
+					"birthdate":    1,
+					"joined_at":    1,
+					"bio":          1,
+					"location":     1,
+					"website":      1,
+					"social_links": 1,
+					"pronouns":     1,
+					"display_name": 1,
+					"version":      1,
 				}, nil,
 			)
+			if err != nil {
+				return err
+			}
 
 			// Get the user's active emails
-			activeEmails, err = d.GetUserActiveEmails(sc, userId)
+			var userEmails []*commonmongodbuser.UserEmail
+			userEmails, err = d.GetUserActiveEmails(sc, userId)
+			if err != nil {
+				return err
+			}
+			for _, userEmail := range userEmails {
+				activeEmails = append(activeEmails, userEmail.Email)
+			}
 
 			// Get the user's phone number
 			userPhoneNumber, err = d.GetUserPhoneNumber(sc, userId)
+			if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+				return err
+			}
 
 			return nil
 		},
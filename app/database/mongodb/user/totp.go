@@ -0,0 +1,240 @@
+package user
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// GetUserTOTP gets the user's TOTP second factor, and returns mongo.ErrNoDocuments if the user has not enrolled
+func (d *Database) GetUserTOTP(
+	ctx context.Context,
+	userId string,
+) (*UserTOTP, error) {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the user's TOTP
+	userTOTP := &UserTOTP{}
+	if err = d.GetCollection(UserTOTPCollection).FindOne(
+		ctx,
+		bson.M{"_id": userObjectIds[0]},
+	).Decode(userTOTP); err != nil {
+		return nil, err
+	}
+	return userTOTP, nil
+}
+
+// IsUserTOTPEnabled checks if the user has a confirmed TOTP second factor
+func (d *Database) IsUserTOTPEnabled(
+	ctx context.Context,
+	userId string,
+) (bool, error) {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return false, err
+	}
+
+	count, err := d.GetCollection(UserTOTPCollection).CountDocuments(
+		ctx,
+		bson.M{"_id": userObjectIds[0], "confirmed_at": bson.M{"$exists": true}},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// SetPendingUserTOTP sets the secret of a pending TOTP second factor, replacing the previous pending one, and
+// returns TOTPAlreadyEnabledError if the user has already confirmed a TOTP
+func (d *Database) SetPendingUserTOTP(
+	ctx context.Context,
+	userId string,
+	secret string,
+) error {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return err
+	}
+
+	// Replace the pending TOTP, the upsert conflicts with the confirmed one
+	_, err = d.GetCollection(UserTOTPCollection).ReplaceOne(
+		ctx,
+		bson.M{"_id": userObjectIds[0], "confirmed_at": bson.M{"$exists": false}},
+		&UserTOTP{
+			ID:        userObjectIds[0],
+			Secret:    secret,
+			CreatedAt: time.Now(),
+		},
+		options.Replace().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return TOTPAlreadyEnabledError
+	}
+	return err
+}
+
+// ConfirmUserTOTP confirms the pending TOTP second factor with the hashed recovery codes, and returns
+// mongo.ErrNoDocuments if there is no pending TOTP
+func (d *Database) ConfirmUserTOTP(
+	ctx context.Context,
+	userId string,
+	step int64,
+	hashedRecoveryCodes []string,
+) error {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return err
+	}
+
+	result, err := d.GetCollection(UserTOTPCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": userObjectIds[0], "confirmed_at": bson.M{"$exists": false}},
+		bson.M{
+			"$set": bson.M{
+				"confirmed_at":   time.Now(),
+				"last_used_step": step,
+				"recovery_codes": hashedRecoveryCodes,
+			},
+			"$unset": bson.M{"failed_attempts": "", "locked_until": ""},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteUserTOTP deletes the user's TOTP second factor and its recovery codes
+func (d *Database) DeleteUserTOTP(
+	ctx context.Context,
+	userId string,
+) error {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.GetCollection(UserTOTPCollection).DeleteOne(ctx, bson.M{"_id": userObjectIds[0]})
+	return err
+}
+
+// CountUserTOTPAttempt counts a second factor attempt before its code is checked, and locks the second factor for the
+// lockout duration when the maximum number of attempts is reached. The lock is checked in the same update, so
+// concurrent attempts can't exceed the maximum, and it returns TOTPLockedError if the second factor is locked
+func (d *Database) CountUserTOTPAttempt(
+	ctx context.Context,
+	userId string,
+	maxAttempts int,
+	lockoutDuration time.Duration,
+) error {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return err
+	}
+
+	// Count the attempt if the second factor is not locked, and lock it and start counting again after the lockout
+	// when it is the last attempt
+	now := time.Now()
+	isLastAttempt := bson.M{"$gte": bson.A{"$failed_attempts", maxAttempts}}
+	result, err := d.GetCollection(UserTOTPCollection).UpdateOne(
+		ctx,
+		bson.M{
+			"_id": userObjectIds[0],
+			"$or": bson.A{
+				bson.M{"locked_until": bson.M{"$exists": false}},
+				bson.M{"locked_until": bson.M{"$lte": now}},
+			},
+		},
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"failed_attempts": bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$failed_attempts", 0}}, 1}},
+			}}},
+			{{Key: "$set", Value: bson.M{
+				"locked_until":    bson.M{"$cond": bson.A{isLastAttempt, now.Add(lockoutDuration), "$locked_until"}},
+				"failed_attempts": bson.M{"$cond": bson.A{isLastAttempt, 0, "$failed_attempts"}},
+			}}},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return TOTPLockedError
+	}
+	return nil
+}
+
+// RecordUserTOTPSuccess resets the counted second factor attempts, and stores the period of the used TOTP code so it
+// can't be used again. A zero step keeps the last used period, otherwise it returns mongo.ErrNoDocuments if a code of
+// the same or a later period was used by a concurrent request
+func (d *Database) RecordUserTOTPSuccess(
+	ctx context.Context,
+	userId string,
+	step int64,
+) error {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": userObjectIds[0]}
+	update := bson.M{"$unset": bson.M{"failed_attempts": "", "locked_until": ""}}
+	if step > 0 {
+		filter["$or"] = bson.A{
+			bson.M{"last_used_step": bson.M{"$exists": false}},
+			bson.M{"last_used_step": bson.M{"$lt": step}},
+		}
+		update["$set"] = bson.M{"last_used_step": step}
+	}
+	result, err := d.GetCollection(UserTOTPCollection).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// ConsumeUserRecoveryCode removes the hashed recovery code, so it can't be used again, and returns
+// mongo.ErrNoDocuments if it was already used
+func (d *Database) ConsumeUserRecoveryCode(
+	ctx context.Context,
+	userId string,
+	hashedRecoveryCode string,
+) error {
+	// Convert the user ID to an object ID
+	userObjectIds, err := getUserObjectIds(userId)
+	if err != nil {
+		return err
+	}
+
+	result, err := d.GetCollection(UserTOTPCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": userObjectIds[0], "recovery_codes": hashedRecoveryCode},
+		bson.M{"$pull": bson.M{"recovery_codes": hashedRecoveryCode}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
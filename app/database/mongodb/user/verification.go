@@ -0,0 +1,217 @@
+package user
+
+import (
+	"context"
+	"errors"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	commonmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb/model/user"
+	appmongodb "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	// EmailVerifiedAtMigration is the migration that marks the emails added before the email verification as verified
+	EmailVerifiedAtMigration = "email_verified_at"
+
+	// EmailVerifiedAtMigrationBatchSize is the number of emails updated at once by the email verified at migration
+	EmailVerifiedAtMigrationBatchSize = 500
+)
+
+// CreateUserEmailVerification revokes the pending verifications of the user's email and creates a new one with the
+// hashed token. It returns mongo.ErrNoDocuments if the user has no pending email with the given address
+func (d *Database) CreateUserEmailVerification(
+	ctx context.Context,
+	userId string,
+	email string,
+	hashedToken string,
+	ttl time.Duration,
+) error {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return err
+	}
+
+	// Run the transaction
+	return appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
+			currentTime := time.Now()
+
+			// Find the user's pending email
+			userEmail, err := d.FindUserEmail(
+				sc,
+				bson.M{
+					"user_id":     *userObjectId,
+					"email":       email,
+					"verified_at": bson.M{"$exists": false},
+					"revoked_at":  bson.M{"$exists": false},
+				},
+				bson.M{"_id": 1},
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			// Revoke the pending verifications, so only the last link sent can be used
+			if _, err = d.GetCollection(UserEmailVerificationCollection).UpdateMany(
+				sc,
+				bson.M{
+					"user_email_id": userEmail.ID,
+					"verified_at":   bson.M{"$exists": false},
+					"revoked_at":    bson.M{"$exists": false},
+				},
+				bson.M{"$set": bson.M{"revoked_at": currentTime}},
+			); err != nil {
+				return err
+			}
+
+			// Insert the new verification
+			_, err = d.GetCollection(UserEmailVerificationCollection).InsertOne(
+				sc, commonmongodbuser.UserEmailVerification{
+					ID:          primitive.NewObjectID(),
+					UserEmailID: userEmail.ID,
+					UUID:        hashedToken,
+					CreatedAt:   currentTime,
+					ExpiresAt:   currentTime.Add(ttl),
+				},
+			)
+			return err
+		},
+	)
+}
+
+// VerifyUserEmailByToken uses the email verification of the hashed token to verify the user's email, and returns the
+// verified email. It returns mongo.ErrNoDocuments if the verification doesn't exist, was already used or revoked, has
+// expired, or belongs to another user
+func (d *Database) VerifyUserEmailByToken(
+	ctx context.Context,
+	userId string,
+	hashedToken string,
+) (email string, err error) {
+	// Convert the user ID to an object ID
+	userObjectId, err := commonmongodb.GetObjectIdFromString(userId)
+	if err != nil {
+		return "", err
+	}
+
+	// Run the transaction
+	err = appmongodb.CreateTransaction(
+		ctx, d.client, func(sc mongo.SessionContext) error {
+			currentTime := time.Now()
+
+			// Mark the verification as used
+			userEmailVerification := &commonmongodbuser.UserEmailVerification{}
+			if err = d.GetCollection(UserEmailVerificationCollection).FindOneAndUpdate(
+				sc,
+				bson.M{
+					"uuid":        hashedToken,
+					"expires_at":  bson.M{"$gt": currentTime},
+					"verified_at": bson.M{"$exists": false},
+					"revoked_at":  bson.M{"$exists": false},
+				},
+				bson.M{"$set": bson.M{"verified_at": currentTime}},
+				options.FindOneAndUpdate().SetProjection(bson.M{"user_email_id": 1}),
+			).Decode(userEmailVerification); err != nil {
+				return err
+			}
+
+			// Get the verified email, which must belong to the user
+			userEmail, err := d.FindUserEmail(
+				sc,
+				bson.M{
+					"_id":        userEmailVerification.UserEmailID,
+					"user_id":    *userObjectId,
+					"revoked_at": bson.M{"$exists": false},
+				},
+				bson.M{"email": 1},
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			// Verify the user's email
+			email = userEmail.Email
+			return d.verifyUserEmail(sc, userObjectId, email)
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return email, nil
+}
+
+// MigrateUserEmailVerifiedAt marks the emails added before the email verification as verified when they were
+// assigned, so they can still become primary and receive password reset links. The emails are updated in batches
+// outside a transaction, so an interrupted run is resumed on the next start, and the migration is only recorded once
+// every batch was applied. It returns the number of migrated emails
+func (d *Database) MigrateUserEmailVerifiedAt(ctx context.Context) (migrated int64, err error) {
+	// Check if the migration was already applied
+	err = d.GetCollection(UserMigrationCollection).FindOne(ctx, bson.M{"_id": EmailVerifiedAtMigration}).Err()
+	if err == nil {
+		return 0, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, err
+	}
+
+	// Only migrate the emails assigned before this run, so the emails pending verification are left untouched
+	filter := bson.M{
+		"verified_at": bson.M{"$exists": false},
+		"revoked_at":  bson.M{"$exists": false},
+		"assigned_at": bson.M{"$lt": time.Now()},
+	}
+	findOptions := commonmongodb.PrepareFindOptions(
+		bson.M{"_id": 1},
+		bson.M{"_id": 1},
+		EmailVerifiedAtMigrationBatchSize,
+		0,
+	)
+	collection := d.GetCollection(UserEmailCollection)
+	for {
+		// Find the next batch of emails to migrate
+		cur, err := collection.Find(ctx, filter, findOptions)
+		if err != nil {
+			return migrated, err
+		}
+		var userEmails []*commonmongodbuser.UserEmail
+		if err = cur.All(ctx, &userEmails); err != nil {
+			return migrated, err
+		}
+		if len(userEmails) == 0 {
+			break
+		}
+
+		// Set the verification time of the batch to the time the emails were assigned
+		userEmailObjectIds := make([]primitive.ObjectID, len(userEmails))
+		for i, userEmail := range userEmails {
+			userEmailObjectIds[i] = userEmail.ID
+		}
+		result, err := collection.UpdateMany(
+			ctx,
+			bson.M{
+				"_id":         bson.M{"$in": userEmailObjectIds},
+				"verified_at": bson.M{"$exists": false},
+			},
+			mongo.Pipeline{{{Key: "$set", Value: bson.M{"verified_at": "$assigned_at"}}}},
+		)
+		if err != nil {
+			return migrated, err
+		}
+		migrated += result.ModifiedCount
+	}
+
+	// Record the migration, ignoring it if another instance recorded it first
+	if _, err = d.GetCollection(UserMigrationCollection).InsertOne(
+		ctx,
+		bson.M{"_id": EmailVerifiedAtMigration, "applied_at": time.Now()},
+	); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return migrated, err
+	}
+	return migrated, nil
+}
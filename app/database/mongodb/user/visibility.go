@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// GetProfileVisibility gets the visibility settings the user has set for the profile fields
+func (d *Database) GetProfileVisibility(
+	ctx context.Context,
+	userId string,
+) (settings map[string]string, err error) {
+	// Find the user
+	user, err := d.FindUserByUserId(ctx, userId, bson.M{"profile_visibility": 1}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return user.ProfileVisibility, nil
+}
+
+// UpdateProfileVisibility sets the visibility of the given profile fields, keeping the other ones unchanged
+func (d *Database) UpdateProfileVisibility(
+	ctx context.Context,
+	userId string,
+	settings map[string]string,
+) error {
+	// Set each field separately, so the fields not included in the settings are kept
+	update := bson.M{}
+	for field, level := range settings {
+		update["profile_visibility."+field] = level
+	}
+
+	_, err := d.UpdateUserByUserId(ctx, userId, update)
+	return err
+}
@@ -0,0 +1,30 @@
+package context
+
+import (
+	"context"
+	"errors"
+	commongrpc "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc"
+	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
+	"google.golang.org/grpc/metadata"
+)
+
+// GetOutgoingCtx returns an outgoing context derived from the incoming one with the token string, so the
+// trace context is propagated to the called service
+func GetOutgoingCtx(ctx context.Context) (context.Context, error) {
+	// Get the token string from the context
+	token, err := commongrpcserverctx.GetCtxTokenString(ctx)
+	if err != nil {
+		// Check if the token is missing
+		if errors.Is(err, commongrpcserverctx.MissingTokenError) {
+			return ctx, nil
+		}
+		return nil, err
+	}
+
+	// Append the token to the gRPC context
+	return metadata.AppendToOutgoingContext(
+		ctx,
+		commongrpc.AuthorizationMetadataKey,
+		token,
+	), nil
+}
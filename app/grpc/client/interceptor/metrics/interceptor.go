@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"context"
+	appmetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// Interceptor is the interceptor for the client metrics
+type Interceptor struct{}
+
+// NewInterceptor creates a new client metrics interceptor
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+// Record returns the interceptor that records the request count and latency of each outgoing RPC
+func (i *Interceptor) Record() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		// Record the metrics with the status code of the response
+		code := status.Code(err).String()
+		appmetrics.GRPCClientRequestsTotal.WithLabelValues(method, code).Inc()
+		appmetrics.GRPCClientRequestDuration.WithLabelValues(
+			method,
+			code,
+		).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
@@ -0,0 +1,10 @@
+package metrics
+
+import (
+	"google.golang.org/grpc"
+)
+
+// Metrics interface
+type Metrics interface {
+	Record() grpc.UnaryClientInterceptor
+}
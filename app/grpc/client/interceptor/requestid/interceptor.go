@@ -0,0 +1,39 @@
+package requestid
+
+import (
+	"context"
+	appgrpcrequestid "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Interceptor is the interceptor for the client request ID
+type Interceptor struct{}
+
+// NewInterceptor creates a new client request ID interceptor
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+// Forward returns the interceptor that forwards the request ID of the context in the outgoing metadata. It
+// must be placed after the authentication interceptor, which replaces the outgoing metadata
+func (i *Interceptor) Forward() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if requestId, ok := appgrpcrequestid.FromContext(ctx); ok {
+			ctx = metadata.AppendToOutgoingContext(
+				ctx,
+				appgrpcrequestid.MetadataKey,
+				requestId,
+			)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,8 @@
+package grpc
+
+import "errors"
+
+var (
+	InvalidMethodValueError = errors.New("must be a comma-separated list of Method=value pairs")
+	UnknownMethodError      = errors.New("has a method that is not served")
+)
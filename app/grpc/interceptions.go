@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+)
+
+// MergeInterceptions merges the interceptions of the services served by the same gRPC server, since they are
+// selected by the method name only
+func MergeInterceptions(
+	interceptions ...*map[pbtypesgrpc.Method]pbtypesgrpc.Interception,
+) *map[pbtypesgrpc.Method]pbtypesgrpc.Interception {
+	merged := make(map[pbtypesgrpc.Method]pbtypesgrpc.Interception)
+	for _, serviceInterceptions := range interceptions {
+		for method, interception := range *serviceInterceptions {
+			merged[method] = interception
+		}
+	}
+	return &merged
+}
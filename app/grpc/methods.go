@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	pbconfiguser "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/user"
+	pbconfiguseradmin "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/useradmin"
+	"strings"
+)
+
+// IsMethod checks if the method is served by the gRPC server
+func IsMethod(method pbtypesgrpc.Method) bool {
+	if _, ok := pbconfiguser.Interceptions[method]; ok {
+		return true
+	}
+	_, ok := pbconfiguseradmin.Interceptions[method]
+	return ok
+}
+
+// ParseMethodValues parses the comma-separated Method=value pairs used to override a setting of some methods, the
+// methods must be served by the gRPC server
+func ParseMethodValues(value string) (map[pbtypesgrpc.Method]string, error) {
+	values := make(map[pbtypesgrpc.Method]string)
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		name, methodValue, ok := strings.Cut(pair, "=")
+		name, methodValue = strings.TrimSpace(name), strings.TrimSpace(methodValue)
+		if !ok || name == "" || methodValue == "" {
+			return nil, InvalidMethodValueError
+		}
+
+		method := pbtypesgrpc.NewMethod(name)
+		if !IsMethod(method) {
+			return nil, UnknownMethodError
+		}
+		values[method] = methodValue
+	}
+	return values, nil
+}
@@ -0,0 +1,10 @@
+package requestid
+
+const (
+	// MetadataKey is the metadata key of the request ID, in the incoming and outgoing requests and in the
+	// response headers and trailers
+	MetadataKey = "x-request-id"
+
+	// MaxLength is the maximum length of an accepted request ID
+	MaxLength = 128
+)
@@ -0,0 +1,40 @@
+package requestid
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// ctxKey is the context key of the request ID
+type ctxKey struct{}
+
+// NewContext returns a context that carries the request ID
+func NewContext(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, requestId)
+}
+
+// FromContext returns the request ID carried by the context
+func FromContext(ctx context.Context) (string, bool) {
+	requestId, ok := ctx.Value(ctxKey{}).(string)
+	return requestId, ok && requestId != ""
+}
+
+// Generate generates a new request ID
+func Generate() string {
+	return uuid.NewString()
+}
+
+// IsValid checks if the request ID received from a caller can be accepted, it must be short and only
+// contain printable ASCII characters
+func IsValid(requestId string) bool {
+	if requestId == "" || len(requestId) > MaxLength {
+		return false
+	}
+
+	for _, c := range requestId {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
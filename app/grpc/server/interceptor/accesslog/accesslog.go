@@ -0,0 +1,11 @@
+package accesslog
+
+import (
+	"google.golang.org/grpc"
+)
+
+// AccessLog interface
+type AccessLog interface {
+	Log() grpc.UnaryServerInterceptor
+	Identify() grpc.UnaryServerInterceptor
+}
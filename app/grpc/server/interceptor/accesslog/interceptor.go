@@ -0,0 +1,84 @@
+package accesslog
+
+import (
+	"context"
+	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"time"
+)
+
+// Interceptor is the interceptor for the server access log
+type Interceptor struct {
+	logger *slog.Logger
+}
+
+// NewInterceptor creates a new server access log interceptor
+func NewInterceptor(logger *slog.Logger) (*Interceptor, error) {
+	// Check if the logger is nil
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &Interceptor{logger: logger}, nil
+}
+
+// Log returns the interceptor that attaches the request-scoped log attributes to the context and writes
+// one line per RPC. It must be the first interceptor of the chain
+func (i *Interceptor) Log() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+
+		// Attach the request-scoped attributes
+		attrs := []slog.Attr{appstructuredlogger.Method(info.FullMethod)}
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			attrs = append(attrs, appstructuredlogger.Peer(p.Addr.String()))
+		}
+		ctx = appstructuredlogger.NewContext(ctx, attrs...)
+
+		resp, err := handler(ctx, req)
+
+		// Write the access log line with the status code of the response
+		code := status.Code(err)
+		level := slog.LevelInfo
+		switch code {
+		case codes.OK:
+		case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+			level = slog.LevelError
+		default:
+			level = slog.LevelWarn
+		}
+		i.logger.LogAttrs(
+			ctx,
+			level,
+			"RPC handled",
+			appstructuredlogger.Code(code.String()),
+			appstructuredlogger.Duration(time.Since(start)),
+		)
+
+		return resp, err
+	}
+}
+
+// Identify returns the interceptor that adds the user ID of the token claims to the request-scoped log
+// attributes. It must be placed after the authentication interceptor
+func (i *Interceptor) Identify() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx); err == nil {
+			appstructuredlogger.AddAttrs(ctx, appstructuredlogger.UserId(userId))
+		}
+
+		return handler(ctx, req)
+	}
+}
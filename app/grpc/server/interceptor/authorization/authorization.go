@@ -0,0 +1,10 @@
+package authorization
+
+import (
+	"google.golang.org/grpc"
+)
+
+// Authorization interface
+type Authorization interface {
+	Authorize() grpc.UnaryServerInterceptor
+}
@@ -0,0 +1,9 @@
+package authorization
+
+const (
+	// MissingPermission is the message returned when none of the caller roles grants the permission required by the method
+	MissingPermission = "missing required permission"
+
+	// UnavailablePermissions is the message returned when the auth service couldn't be asked for the caller permissions
+	UnavailablePermissions = "couldn't check the caller permissions, retry later"
+)
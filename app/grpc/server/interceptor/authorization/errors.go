@@ -0,0 +1,8 @@
+package authorization
+
+import "errors"
+
+var (
+	NilMethodPermissionsError = errors.New("method permissions cannot be nil")
+	NilRoleClientError        = errors.New("role client cannot be nil")
+)
@@ -0,0 +1,102 @@
+package authorization
+
+import (
+	"context"
+	commongrpcinfo "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/info"
+	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	appgrpcclientctx "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/client/context"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	approle "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/role"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+)
+
+// Interceptor is the interceptor for the authorization
+type Interceptor struct {
+	roleClient        *approle.Client
+	methodPermissions *map[pbtypesgrpc.Method]approle.Permission
+	logger            *slog.Logger
+}
+
+// NewInterceptor creates a new authorization interceptor
+func NewInterceptor(
+	roleClient *approle.Client,
+	methodPermissions *map[pbtypesgrpc.Method]approle.Permission,
+	logger *slog.Logger,
+) (*Interceptor, error) {
+	// Check if the role client is nil
+	if roleClient == nil {
+		return nil, NilRoleClientError
+	}
+
+	// Check if the method permissions are nil
+	if methodPermissions == nil {
+		return nil, NilMethodPermissionsError
+	}
+
+	// Check if the logger is nil
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &Interceptor{
+		roleClient:        roleClient,
+		methodPermissions: methodPermissions,
+		logger:            logger,
+	}, nil
+}
+
+// Authorize returns the interceptor that asks the auth service if the caller roles grant the permission required
+// by the method. It must run after the authentication interceptor
+func (i *Interceptor) Authorize() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// Check if the method requires a permission
+		permission, ok := (*i.methodPermissions)[pbtypesgrpc.NewMethod(
+			commongrpcinfo.GetMethodName(info.FullMethod),
+		)]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		// Get the caller user ID set by the authentication interceptor
+		userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		// Get outgoing gRPC context, the auth service checks the caller's access token
+		grpcCtx, err := appgrpcclientctx.GetOutgoingCtx(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		// Check if the caller roles grant the permission
+		allowed, err := i.roleClient.HasPermission(grpcCtx, userId, permission)
+		if err != nil {
+			// The auth service denies the callers that can't read their own roles
+			if status.Code(err) == codes.PermissionDenied {
+				return nil, status.Error(codes.PermissionDenied, MissingPermission)
+			}
+
+			i.logger.LogAttrs(
+				ctx,
+				slog.LevelError,
+				"Failed to check permissions",
+				appstructuredlogger.Error(err),
+			)
+			return nil, status.Error(codes.Unavailable, UnavailablePermissions)
+		}
+		if !allowed {
+			return nil, status.Error(codes.PermissionDenied, MissingPermission)
+		}
+
+		return handler(ctx, req)
+	}
+}
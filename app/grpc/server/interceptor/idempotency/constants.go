@@ -0,0 +1,18 @@
+package idempotency
+
+const (
+	// UnknownClientIP is the client IP of the keys sent without an access token, when the client address is unknown
+	UnknownClientIP = "unknown"
+
+	// KeyTooLong is the message of the requests with an idempotency key that is too long
+	KeyTooLong = "idempotency key is too long"
+
+	// KeyReused is the message of the requests that reuse an idempotency key with a different payload
+	KeyReused = "idempotency key was already used with a different request"
+
+	// RequestInProgress is the message of the retries sent while the original request is in progress
+	RequestInProgress = "a request with the same idempotency key is in progress, retry later"
+
+	// FailedToReplay is the message of the retries whose original response could not be decoded
+	FailedToReplay = "failed to replay the original response"
+)
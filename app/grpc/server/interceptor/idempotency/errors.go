@@ -0,0 +1,12 @@
+package idempotency
+
+import "errors"
+
+var (
+	NilStoreError            = errors.New("idempotency store cannot be nil")
+	NilMethodsError          = errors.New("idempotent methods cannot be nil")
+	NilMethodTimeoutsError   = errors.New("method timeouts cannot be nil")
+	InvalidTTLError          = errors.New("idempotency ttl must be positive")
+	ShortSecretError         = errors.New("idempotency secret is too short")
+	NilClientIPResolverError = errors.New("client ip resolver cannot be nil")
+)
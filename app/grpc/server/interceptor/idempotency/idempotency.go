@@ -0,0 +1,10 @@
+package idempotency
+
+import (
+	"google.golang.org/grpc"
+)
+
+// Idempotency interface
+type Idempotency interface {
+	Replay() grpc.UnaryServerInterceptor
+}
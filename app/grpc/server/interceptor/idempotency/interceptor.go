@@ -0,0 +1,235 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	commongrpcinfo "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/info"
+	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	appclientip "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/clientip"
+	appidempotency "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/idempotency"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"log/slog"
+	"time"
+)
+
+// Interceptor is the interceptor that replays the responses of the retried requests
+type Interceptor struct {
+	store            appidempotency.Store
+	methods          *map[pbtypesgrpc.Method]bool
+	methodTimeouts   *map[pbtypesgrpc.Method]time.Duration
+	secret           []byte
+	clientIPResolver *appclientip.Resolver
+	ttl              time.Duration
+	queryTimeout     time.Duration
+	logger           *slog.Logger
+}
+
+// NewInterceptor creates a new server idempotency interceptor
+func NewInterceptor(
+	store appidempotency.Store,
+	methods *map[pbtypesgrpc.Method]bool,
+	methodTimeouts *map[pbtypesgrpc.Method]time.Duration,
+	secret string,
+	clientIPResolver *appclientip.Resolver,
+	ttl time.Duration,
+	queryTimeout time.Duration,
+	logger *slog.Logger,
+) (*Interceptor, error) {
+	// Check if the store is nil
+	if store == nil {
+		return nil, NilStoreError
+	}
+
+	// Check if the methods are nil
+	if methods == nil {
+		return nil, NilMethodsError
+	}
+
+	// Check if the method timeouts are nil
+	if methodTimeouts == nil {
+		return nil, NilMethodTimeoutsError
+	}
+
+	// Check if the fingerprint secret is long enough
+	if appidempotency.ValidateSecret(secret) != nil {
+		return nil, ShortSecretError
+	}
+
+	// Check if the client IP resolver is nil
+	if clientIPResolver == nil {
+		return nil, NilClientIPResolverError
+	}
+
+	// Check if the TTLs are valid
+	if ttl <= 0 || queryTimeout <= 0 {
+		return nil, InvalidTTLError
+	}
+
+	// Check if the logger is nil
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &Interceptor{
+		store:            store,
+		methods:          methods,
+		methodTimeouts:   methodTimeouts,
+		secret:           []byte(secret),
+		clientIPResolver: clientIPResolver,
+		ttl:              ttl,
+		queryTimeout:     queryTimeout,
+		logger:           logger,
+	}, nil
+}
+
+// GetKey returns the stored key of the request, scoped by the method and the user ID of the token claims, or the
+// client IP forwarded by the trusted proxies if the request is not authenticated. The anonymous clients behind the
+// same address share the scope of their keys
+func (i *Interceptor) GetKey(ctx context.Context, methodName string, key string) string {
+	if userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx); err == nil {
+		return appidempotency.Key(methodName, "user:"+userId, key)
+	}
+
+	ip, err := i.clientIPResolver.GetClientIP(ctx)
+	if err != nil {
+		ip = UnknownClientIP
+	}
+	return appidempotency.Key(methodName, "ip:"+ip, key)
+}
+
+// GetLockTTL returns the time a key is reserved by a request in progress, which is the timeout of the method plus the
+// time to store its response, so the key of a request that never completed can be used again
+func (i *Interceptor) GetLockTTL(methodName string) time.Duration {
+	timeout, ok := (*i.methodTimeouts)[pbtypesgrpc.NewMethod(methodName)]
+	if !ok {
+		timeout = i.queryTimeout
+	}
+	return timeout + i.queryTimeout
+}
+
+// failed logs a store failure
+func (i *Interceptor) failed(ctx context.Context, message string, err error) {
+	i.logger.LogAttrs(ctx, slog.LevelError, message, appstructuredlogger.Error(err))
+}
+
+// finish stores the response of the request, or releases its key if it failed. It's not canceled with the request,
+// so the key isn't left reserved if the caller goes away
+func (i *Interceptor) finish(ctx context.Context, key string, response interface{}, handlerErr error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), i.queryTimeout)
+	defer cancel()
+
+	// Release the key of the failed request, so it can be retried
+	if handlerErr != nil {
+		if err := i.store.ReleaseIdempotencyKey(ctx, key); err != nil {
+			i.failed(ctx, "Failed to release idempotency key", err)
+		}
+		return
+	}
+
+	// Encode the response with its type, so it can be decoded without knowing the method
+	message, ok := response.(proto.Message)
+	if !ok {
+		return
+	}
+	encodedResponse, err := anypb.New(message)
+	if err != nil {
+		i.failed(ctx, "Failed to encode idempotent response", err)
+		return
+	}
+	data, err := proto.Marshal(encodedResponse)
+	if err != nil {
+		i.failed(ctx, "Failed to encode idempotent response", err)
+		return
+	}
+
+	if err = i.store.CompleteIdempotencyKey(ctx, key, data, i.ttl); err != nil {
+		i.failed(ctx, "Failed to complete idempotency key", err)
+	}
+}
+
+// replay decodes the stored response of a completed request
+func (i *Interceptor) replay(record *appidempotency.Record) (interface{}, error) {
+	encodedResponse := &anypb.Any{}
+	if err := proto.Unmarshal(record.Response, encodedResponse); err != nil {
+		return nil, err
+	}
+	return encodedResponse.UnmarshalNew()
+}
+
+// Replay returns the interceptor that runs the requests sent with an idempotency key once, and replays the original
+// response to the retries. It must be placed after the authentication interceptor, so the keys are scoped by user
+// when possible
+func (i *Interceptor) Replay() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// Check if the method accepts an idempotency key
+		methodName := commongrpcinfo.GetMethodName(info.FullMethod)
+		if !(*i.methods)[pbtypesgrpc.NewMethod(methodName)] {
+			return handler(ctx, req)
+		}
+
+		// Get the idempotency key from the metadata, the request is run as usual if it is not provided
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(appidempotency.MetadataKey)
+		if len(values) == 0 || values[0] == "" {
+			return handler(ctx, req)
+		}
+		if len(values[0]) > appidempotency.MaxKeyLength {
+			return nil, status.Error(codes.InvalidArgument, KeyTooLong)
+		}
+
+		// Get the request fingerprint
+		request, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		fingerprint, err := appidempotency.Fingerprint(i.secret, request)
+		if err != nil {
+			i.failed(ctx, "Failed to fingerprint idempotent request", err)
+			return handler(ctx, req)
+		}
+
+		// Reserve the key, the request is let through if the store fails
+		key := i.GetKey(ctx, methodName, values[0])
+		record, err := i.store.ReserveIdempotencyKey(ctx, key, fingerprint, i.GetLockTTL(methodName))
+		if err != nil && !errors.Is(err, appidempotency.KeyExistsError) {
+			i.failed(ctx, "Failed to reserve idempotency key", err)
+			return handler(ctx, req)
+		}
+
+		// Check if the key was already used
+		if err != nil {
+			if record.Fingerprint != fingerprint {
+				i.logger.LogAttrs(ctx, slog.LevelWarn, "Idempotency key reused with a different request")
+				return nil, status.Error(codes.FailedPrecondition, KeyReused)
+			}
+			if !record.Completed {
+				return nil, status.Error(codes.Aborted, RequestInProgress)
+			}
+
+			// Replay the original response
+			response, err := i.replay(record)
+			if err != nil {
+				i.failed(ctx, "Failed to decode idempotent response", err)
+				return nil, status.Error(codes.Internal, FailedToReplay)
+			}
+			i.logger.LogAttrs(ctx, slog.LevelInfo, "Replayed idempotent response")
+			return response, nil
+		}
+
+		// Run the request and store its response
+		response, err := handler(ctx, req)
+		i.finish(ctx, key, response, err)
+		return response, err
+	}
+}
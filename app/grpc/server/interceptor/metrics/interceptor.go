@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"context"
+	appmetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// Interceptor is the interceptor for the server metrics
+type Interceptor struct{}
+
+// NewInterceptor creates a new server metrics interceptor
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+// Record returns the interceptor that records the request count and latency of each RPC
+func (i *Interceptor) Record() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		// Record the metrics with the status code of the response
+		code := status.Code(err).String()
+		appmetrics.GRPCServerRequestsTotal.WithLabelValues(
+			info.FullMethod,
+			code,
+		).Inc()
+		appmetrics.GRPCServerRequestDuration.WithLabelValues(
+			info.FullMethod,
+			code,
+		).Observe(time.Since(start).Seconds())
+
+		return resp, err
+	}
+}
@@ -0,0 +1,85 @@
+package optionalauth
+
+import (
+	"context"
+	"errors"
+	commonvalidator "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt/validator"
+	commonvalidatorgrpc "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt/validator/grpc"
+	commongrpc "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc"
+	commongrpcinfo "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/info"
+	commongrpcmd "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/metadata"
+	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Interceptor is the interceptor for the methods that accept both anonymous and authenticated callers
+type Interceptor struct {
+	validator         commonvalidator.Validator
+	grpcInterceptions *map[pbtypesgrpc.Method]pbtypesgrpc.Interception
+}
+
+// NewInterceptor creates a new optional authentication interceptor
+func NewInterceptor(
+	validator commonvalidator.Validator,
+	grpcInterceptions *map[pbtypesgrpc.Method]pbtypesgrpc.Interception,
+) (*Interceptor, error) {
+	// Check if either the validator or the gRPC interceptions is nil
+	if validator == nil {
+		return nil, commonvalidator.NilValidatorError
+	}
+	if grpcInterceptions == nil {
+		return nil, commongrpc.NilGRPCInterceptionsError
+	}
+
+	return &Interceptor{
+		validator:         validator,
+		grpcInterceptions: grpcInterceptions,
+	}, nil
+}
+
+// Authenticate returns the interceptor that sets the token claims to the context if the caller sent a token. The
+// request continues as anonymous without a token, and fails if the token is not valid
+func (i *Interceptor) Authenticate() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// Check if the method accepts an optional token
+		interception, ok := (*i.grpcInterceptions)[pbtypesgrpc.NewMethod(
+			commongrpcinfo.GetMethodName(info.FullMethod),
+		)]
+		if !ok || interception == pbtypesgrpc.None {
+			return handler(ctx, req)
+		}
+
+		// Get the token from the metadata, the caller is anonymous if it is not provided
+		md, _ := metadata.FromIncomingContext(ctx)
+		tokenString, err := commongrpcmd.GetAuthorizationTokenFromMetadata(md)
+		if errors.Is(err, commongrpc.AuthorizationMetadataNotProvidedError) {
+			return handler(ctx, req)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		// Validate the token and get the validated claims
+		claims, err := i.validator.GetValidatedClaims(tokenString, interception)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, status.Error(codes.Unauthenticated, commonvalidatorgrpc.TokenNotFoundOrExpiredError.Error())
+			}
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		// Set the token string and token claims to the context
+		ctx = commongrpcserverctx.SetCtxTokenString(ctx, tokenString)
+		ctx = commongrpcserverctx.SetCtxTokenClaims(ctx, claims)
+
+		return handler(ctx, req)
+	}
+}
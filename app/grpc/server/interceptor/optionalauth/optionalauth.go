@@ -0,0 +1,10 @@
+package optionalauth
+
+import (
+	"google.golang.org/grpc"
+)
+
+// OptionalAuthentication interface
+type OptionalAuthentication interface {
+	Authenticate() grpc.UnaryServerInterceptor
+}
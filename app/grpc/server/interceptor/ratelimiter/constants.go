@@ -0,0 +1,9 @@
+package ratelimiter
+
+const (
+	// TooManyRequests is the message of the rejected requests
+	TooManyRequests = "too many requests, retry later"
+
+	// UnknownClientIP is the client IP of the requests whose peer address is unknown
+	UnknownClientIP = "unknown"
+)
@@ -0,0 +1,10 @@
+package ratelimiter
+
+import "errors"
+
+var (
+	NilLimiterError          = errors.New("rate limiter cannot be nil")
+	NilMethodLimitsError     = errors.New("method limits cannot be nil")
+	NilSubjectMethodsError   = errors.New("subject methods cannot be nil")
+	NilClientIPResolverError = errors.New("client IP resolver cannot be nil")
+)
@@ -0,0 +1,187 @@
+package ratelimiter
+
+import (
+	"context"
+	commongrpcinfo "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/info"
+	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	appclientip "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/clientip"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	appratelimiter "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/ratelimiter"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"log/slog"
+	"net"
+	"strings"
+)
+
+// Interceptor is the interceptor for the server rate limiter
+type Interceptor struct {
+	limiter          appratelimiter.Limiter
+	defaultLimit     appratelimiter.Limit
+	methodLimits     *map[pbtypesgrpc.Method]appratelimiter.Limit
+	subjectMethods   *map[pbtypesgrpc.Method]bool
+	clientIPResolver *appclientip.Resolver
+	exemptCallers    []*net.IPNet
+	logger           *slog.Logger
+}
+
+type (
+	// usernameRequest is a request sent on behalf of the user with the username
+	usernameRequest interface {
+		GetUsername() string
+	}
+
+	// userIdRequest is a request sent on behalf of the user with the ID
+	userIdRequest interface {
+		GetUserId() string
+	}
+
+	// sessionIdRequest is a request that finishes the ceremony started by a session
+	sessionIdRequest interface {
+		GetSessionId() string
+	}
+)
+
+// NewInterceptor creates a new server rate limiter interceptor, the exempt callers are only limited on the subject
+// methods
+func NewInterceptor(
+	limiter appratelimiter.Limiter,
+	defaultLimit appratelimiter.Limit,
+	methodLimits *map[pbtypesgrpc.Method]appratelimiter.Limit,
+	subjectMethods *map[pbtypesgrpc.Method]bool,
+	clientIPResolver *appclientip.Resolver,
+	exemptCallers []*net.IPNet,
+	logger *slog.Logger,
+) (*Interceptor, error) {
+	// Check if the limiter is nil
+	if limiter == nil {
+		return nil, NilLimiterError
+	}
+
+	// Check if the method limits are nil
+	if methodLimits == nil {
+		return nil, NilMethodLimitsError
+	}
+
+	// Check if the subject methods are nil
+	if subjectMethods == nil {
+		return nil, NilSubjectMethodsError
+	}
+
+	// Check if the client IP resolver is nil
+	if clientIPResolver == nil {
+		return nil, NilClientIPResolverError
+	}
+
+	// Check if the logger is nil
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &Interceptor{
+		limiter:          limiter,
+		defaultLimit:     defaultLimit,
+		methodLimits:     methodLimits,
+		subjectMethods:   subjectMethods,
+		clientIPResolver: clientIPResolver,
+		exemptCallers:    exemptCallers,
+		logger:           logger,
+	}, nil
+}
+
+// getSubject returns the username, user ID or session the request is sent on behalf of
+func getSubject(req interface{}) string {
+	switch request := req.(type) {
+	case usernameRequest:
+		if username := request.GetUsername(); username != "" {
+			return "username:" + strings.ToLower(username)
+		}
+	case userIdRequest:
+		if userId := request.GetUserId(); userId != "" {
+			return "user:" + strings.ToLower(userId)
+		}
+	case sessionIdRequest:
+		if sessionId := request.GetSessionId(); sessionId != "" {
+			return "session:" + sessionId
+		}
+	}
+	return ""
+}
+
+// IsExempt checks if the direct caller is a service that is not rate limited
+func (i *Interceptor) IsExempt(ctx context.Context) bool {
+	ip, err := i.clientIPResolver.GetPeerIP(ctx)
+	return err == nil && appclientip.Contains(i.exemptCallers, ip)
+}
+
+// GetKey returns the bucket key of the request, made of the method and the user the auth service sends it on
+// behalf of, the user ID of the token claims, or the IP of the client if the request is not authenticated
+func (i *Interceptor) GetKey(ctx context.Context, methodName string, req interface{}) string {
+	if (*i.subjectMethods)[pbtypesgrpc.NewMethod(methodName)] {
+		if subject := getSubject(req); subject != "" {
+			return methodName + ":" + subject
+		}
+	}
+
+	if userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx); err == nil {
+		return methodName + ":user:" + userId
+	}
+
+	ip, err := i.clientIPResolver.GetClientIP(ctx)
+	if err != nil {
+		ip = UnknownClientIP
+	}
+	return methodName + ":ip:" + ip
+}
+
+// Limit returns the interceptor that rejects the requests that exceed the limit of their method. It must be
+// placed after the authentication interceptor, so the requests are keyed by user when possible
+func (i *Interceptor) Limit() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// Let the requests of the exempt callers through, except the ones sent on behalf of a user
+		methodName := commongrpcinfo.GetMethodName(info.FullMethod)
+		method := pbtypesgrpc.NewMethod(methodName)
+		if !(*i.subjectMethods)[method] && i.IsExempt(ctx) {
+			return handler(ctx, req)
+		}
+
+		// Get the limit of the method
+		limit, ok := (*i.methodLimits)[method]
+		if !ok {
+			limit = i.defaultLimit
+		}
+
+		// Take a token, the request is let through if the backend fails
+		allowed, retryAfter, err := i.limiter.Allow(ctx, i.GetKey(ctx, methodName, req), limit)
+		if err != nil {
+			i.logger.LogAttrs(
+				ctx,
+				slog.LevelError,
+				"Rate limiter failed",
+				appstructuredlogger.Error(err),
+			)
+			return handler(ctx, req)
+		}
+		if allowed {
+			return handler(ctx, req)
+		}
+
+		// Reject the request with the time to wait before retrying
+		i.logger.LogAttrs(ctx, slog.LevelWarn, "Rate limit exceeded")
+		st, err := status.New(codes.ResourceExhausted, TooManyRequests).WithDetails(
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+		)
+		if err != nil {
+			return nil, status.Error(codes.ResourceExhausted, TooManyRequests)
+		}
+		return nil, st.Err()
+	}
+}
@@ -0,0 +1,10 @@
+package ratelimiter
+
+import (
+	"google.golang.org/grpc"
+)
+
+// RateLimiter interface
+type RateLimiter interface {
+	Limit() grpc.UnaryServerInterceptor
+}
@@ -0,0 +1,51 @@
+package requestid
+
+import (
+	"context"
+	appgrpcrequestid "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/requestid"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Interceptor is the interceptor for the server request ID
+type Interceptor struct{}
+
+// NewInterceptor creates a new server request ID interceptor
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+// Attach returns the interceptor that accepts the request ID of the incoming metadata, or generates a new one,
+// attaches it to the context and the log attributes, and returns it in the response headers and trailers.
+// It must be placed after the access log interceptor
+func (i *Interceptor) Attach() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// Get the request ID from the incoming metadata
+		var requestId string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(appgrpcrequestid.MetadataKey); len(values) > 0 {
+				requestId = values[0]
+			}
+		}
+
+		// Generate a new request ID if it is missing or invalid
+		if !appgrpcrequestid.IsValid(requestId) {
+			requestId = appgrpcrequestid.Generate()
+		}
+
+		// Attach the request ID to the context and the log attributes
+		ctx = appgrpcrequestid.NewContext(ctx, requestId)
+		appstructuredlogger.AddAttrs(ctx, appstructuredlogger.RequestId(requestId))
+
+		// Return the request ID in the response headers and trailers
+		md := metadata.Pairs(appgrpcrequestid.MetadataKey, requestId)
+		_ = grpc.SetHeader(ctx, md)
+		_ = grpc.SetTrailer(ctx, md)
+
+		return handler(ctx, req)
+	}
+}
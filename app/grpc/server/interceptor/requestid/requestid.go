@@ -0,0 +1,10 @@
+package requestid
+
+import (
+	"google.golang.org/grpc"
+)
+
+// RequestId interface
+type RequestId interface {
+	Attach() grpc.UnaryServerInterceptor
+}
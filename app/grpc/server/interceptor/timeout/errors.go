@@ -0,0 +1,8 @@
+package timeout
+
+import "errors"
+
+var (
+	NilMethodTimeoutsError = errors.New("method timeouts cannot be nil")
+	InvalidTimeoutError    = errors.New("timeout must be positive")
+)
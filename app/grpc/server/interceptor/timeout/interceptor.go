@@ -0,0 +1,58 @@
+package timeout
+
+import (
+	"context"
+	commongrpcinfo "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/info"
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	"google.golang.org/grpc"
+	"time"
+)
+
+// Interceptor is the interceptor for the server timeouts
+type Interceptor struct {
+	defaultTimeout time.Duration
+	methodTimeouts *map[pbtypesgrpc.Method]time.Duration
+}
+
+// NewInterceptor creates a new server timeout interceptor
+func NewInterceptor(
+	defaultTimeout time.Duration,
+	methodTimeouts *map[pbtypesgrpc.Method]time.Duration,
+) (*Interceptor, error) {
+	// Check if the default timeout is valid
+	if defaultTimeout <= 0 {
+		return nil, InvalidTimeoutError
+	}
+
+	// Check if the method timeouts are nil
+	if methodTimeouts == nil {
+		return nil, NilMethodTimeoutsError
+	}
+
+	return &Interceptor{
+		defaultTimeout: defaultTimeout,
+		methodTimeouts: methodTimeouts,
+	}, nil
+}
+
+// Apply returns the interceptor that sets the timeout of the method to the context. The deadline set by the
+// caller is kept if it is earlier
+func (i *Interceptor) Apply() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// Get the timeout of the method
+		timeout, ok := (*i.methodTimeouts)[pbtypesgrpc.NewMethod(
+			commongrpcinfo.GetMethodName(info.FullMethod),
+		)]
+		if !ok {
+			timeout = i.defaultTimeout
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,10 @@
+package timeout
+
+import (
+	"google.golang.org/grpc"
+)
+
+// Timeout interface
+type Timeout interface {
+	Apply() grpc.UnaryServerInterceptor
+}
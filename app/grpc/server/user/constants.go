@@ -1,32 +1,92 @@
 package user
 
 const (
-	SignedUp                = "successfully signed up"
-	PasswordIsCorrect       = "password is correct"
-	PasswordIsIncorrect     = "password is incorrect"
-	FailedToComparePassword = "password is incorrect or user does not exist"
-	FoundByUsername         = "user found by username"
-	NotFoundByUsername      = "user not found by username"
-	FoundByUserId           = "user found by user id"
-	NotFoundByUserId        = "user not found by user id"
-	FoundByUserSharedId     = "user found by user shared id"
-	NotFoundByUserSharedId  = "user not found by user shared id"
-	Updated                 = "user updated successfully"
-	FetchedUserProfile      = "fetched user profile successfully"
-	FetchedPhoneNumber      = "fetched user phone number successfully"
-	UsernameExists          = "username exists"
-	UpdatedUsername         = "username changed successfully"
-	UpdatedPassword         = "password changed successfully"
-	UpdatedPhoneNumber      = "phone number changed successfully"
-	DeletedUser             = "user deleted successfully"
-	AddedUserEmail          = "email added successfully"
-	FailedToAddUserEmail    = "email already exists"
-	FoundUserEmail          = "user email found"
-	NotFoundUserEmail       = "user email not found"
-	UpdatedUserPrimaryEmail = "primary email changed successfully"
-	DeletedUserEmail        = "email deleted successfully"
-	FailedToDeleteUserEmail = "email does not exist or is the primary email"
-	FetchedUserPrimaryEmail = "fetched primary email successfully"
-	FetchedUserActiveEmails = "fetched active emails successfully"
-	FetchedUserOwnProfile   = "fetched own profile successfully"
+	SignedUp                    = "successfully signed up"
+	PasswordIsCorrect           = "password is correct"
+	PasswordIsIncorrect         = "password is incorrect"
+	FailedToComparePassword     = "password is incorrect or user does not exist"
+	FoundByUsername             = "user found by username"
+	NotFoundByUsername          = "user not found by username"
+	FoundByUserId               = "user found by user id"
+	NotFoundByUserId            = "user not found by user id"
+	FoundByUserIds              = "users found by user ids"
+	FoundByUsernames            = "users found by usernames"
+	FoundByUserSharedId         = "user found by user shared id"
+	NotFoundByUserSharedId      = "user not found by user shared id"
+	Updated                     = "user updated successfully"
+	FetchedUserProfile          = "fetched user profile successfully"
+	SearchedUsers               = "searched users successfully"
+	FetchedPhoneNumber          = "fetched user phone number successfully"
+	UsernameExists              = "username exists"
+	UpdatedUsername             = "username changed successfully"
+	UpdatedPassword             = "password changed successfully"
+	UpdatedPhoneNumber          = "phone number changed successfully"
+	DeletedUser                 = "user deleted successfully"
+	AddedUserEmail              = "email added successfully"
+	FailedToAddUserEmail        = "email already exists"
+	FoundUserEmail              = "user email found"
+	NotFoundUserEmail           = "user email not found"
+	UserEmailNotVerified        = "email must be verified before it can be the primary email"
+	UpdatedUserPrimaryEmail     = "primary email changed successfully"
+	DeletedUserEmail            = "email deleted successfully"
+	FailedToDeleteUserEmail     = "email does not exist or is the primary email"
+	FetchedUserPrimaryEmail     = "fetched primary email successfully"
+	FetchedUserActiveEmails     = "fetched active emails successfully"
+	FetchedUserOwnProfile       = "fetched own profile successfully"
+	FetchedProfileVisibility    = "fetched profile visibility successfully"
+	UpdatedProfileVisibility    = "profile visibility updated successfully"
+	FetchedPreferences          = "fetched preferences successfully"
+	UpdatedPreferences          = "preferences updated successfully"
+	BlockedUser                 = "user blocked successfully"
+	UnblockedUser               = "user unblocked successfully"
+	UserAlreadyBlocked          = "user is already blocked"
+	UserNotBlocked              = "user is not blocked"
+	CannotBlockSelf             = "users cannot block themselves"
+	ListedBlockedUsers          = "listed blocked users successfully"
+	CheckedIfBlocked            = "checked if blocked successfully"
+	SecondFactorIsRequired      = "password is correct, second factor is required"
+	SecondFactorIsIncorrect     = "second factor code is incorrect"
+	SecondFactorIsLocked        = "too many failed second factor attempts, try again later"
+	VerifiedSecondFactor        = "second factor verified successfully"
+	EnrolledTOTP                = "totp enrolled successfully, confirm it with a code"
+	ConfirmedTOTP               = "totp enabled successfully"
+	DisabledTOTP                = "totp disabled successfully"
+	TOTPIsAlreadyEnabled        = "totp is already enabled"
+	TOTPIsNotEnrolled           = "totp is not enrolled"
+	TOTPIsNotEnabled            = "totp is not enabled"
+	UserIsLocked                = "user account is locked"
+	PasswordResetIsRequired     = "password reset is required, request a password reset link"
+	PasskeysAreDisabled         = "passkeys are not enabled"
+	StartedPasskeyCeremony      = "passkey ceremony started successfully, finish it with the authenticator response"
+	PasskeySessionNotFound      = "passkey session not found or expired"
+	PasskeyIsInvalid            = "passkey response could not be verified"
+	PasskeyIsRegistered         = "passkey is already registered"
+	RegisteredPasskey           = "passkey registered successfully"
+	NoPasskeysRegistered        = "user has no passkeys registered"
+	VerifiedPasskey             = "passkey verified successfully"
+	PasskeyIsCloned             = "passkey may have been cloned, revoke it and register it again"
+	ListedPasskeys              = "listed passkeys successfully"
+	RevokedPasskey              = "passkey revoked successfully"
+	NotFoundPasskey             = "passkey not found"
+	UserVersionMismatch         = "user was modified by another request, fetch it again and retry"
+	EmailsAreDisabled           = "emails are not enabled"
+	SentResetPassword           = "if the user has a verified primary email, a password reset link was sent to it"
+	ResetPasswordTokenIsInvalid = "password reset link is invalid or expired"
+	PasswordReset               = "password reset successfully"
+	SentVerificationEmail       = "email verification link sent successfully"
+	PendingEmailNotFound        = "email not found or already verified"
+	VerifyEmailTokenIsInvalid   = "email verification link is invalid or expired"
+	VerifiedEmail               = "email verified successfully"
+)
+
+// Details of the second factor required status, for the auth service to continue the login
+const (
+	// SecondFactorRequiredReason is the reason of the error info detail
+	SecondFactorRequiredReason = "SECOND_FACTOR_REQUIRED"
+
+	// SecondFactorRequiredDomain is the domain of the error info detail
+	SecondFactorRequiredDomain = "user.pixel_plaza"
+
+	// UserIdMetadataKey is the error info metadata key of the ID of the user who must verify the second factor
+	UserIdMetadataKey = "user_id"
 )
@@ -1,11 +1,30 @@
 package user
 
 import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 var (
-	InternalServerError = status.Error(codes.Internal, "internal server error")
-	InDevelopmentError  = status.Error(codes.Internal, "in development")
+	InternalServerError   = status.Error(codes.Internal, "internal server error")
+	InDevelopmentError    = status.Error(codes.Internal, "in development")
+	CanceledError         = status.Error(codes.Canceled, "request canceled")
+	DeadlineExceededError = status.Error(codes.DeadlineExceeded, "request deadline exceeded")
 )
+
+// InternalError returns the error of a failed request, which is the internal server error unless the request
+// was canceled or its deadline was exceeded
+func InternalError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(ctx.Err(), context.Canceled):
+		return CanceledError
+	case errors.Is(err, context.DeadlineExceeded), mongo.IsTimeout(err),
+		errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return DeadlineExceededError
+	default:
+		return InternalServerError
+	}
+}
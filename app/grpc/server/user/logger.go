@@ -1,13 +1,20 @@
 package user
 
-import commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
-
+import (
+	"context"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	appmetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/metrics"
+	"log/slog"
+)
+
+// Logger is the logger for the user server, with a typed helper for each event
 type Logger struct {
-	logger commonlogger.Logger
+	logger *slog.Logger
 }
 
 // NewLogger is the logger for the user database
-func NewLogger(logger commonlogger.Logger) (*Logger, error) {
+func NewLogger(logger *slog.Logger) (*Logger, error) {
 	// Check if the logger is nil
 	if logger == nil {
 		return nil, commonlogger.NilLoggerError
@@ -16,437 +23,750 @@ func NewLogger(logger commonlogger.Logger) (*Logger, error) {
 	return &Logger{logger: logger}, nil
 }
 
+// success logs a successful event
+func (l *Logger) success(ctx context.Context, message string, attrs ...slog.Attr) {
+	l.logger.LogAttrs(ctx, slog.LevelInfo, message, attrs...)
+}
+
+// failed logs an event that failed because of the request
+func (l *Logger) failed(ctx context.Context, message string, attrs ...slog.Attr) {
+	l.logger.LogAttrs(ctx, slog.LevelWarn, message, attrs...)
+}
+
+// failure logs an event that failed with an error
+func (l *Logger) failure(ctx context.Context, message string, err error) {
+	l.logger.LogAttrs(ctx, slog.LevelError, message, appstructuredlogger.Error(err))
+}
+
 // SignedUp logs that the user signed up
-func (l *Logger) SignedUp(userId string, username string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User signed up",
-			commonlogger.StatusSuccess,
-			userId,
-			username,
-		),
+func (l *Logger) SignedUp(ctx context.Context, userId string, username string) {
+	appmetrics.SignUpsTotal.Inc()
+	l.success(
+		ctx,
+		"User signed up",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Username(username),
 	)
 }
 
 // FailedToSignUp logs the user sign up failure
-func (l *Logger) FailedToSignUp(err error) {
-	l.logger.LogError(commonlogger.NewLogError("User sign up failed", err))
+func (l *Logger) FailedToSignUp(ctx context.Context, err error) {
+	l.failure(ctx, "User sign up failed", err)
 }
 
 // PasswordIsCorrect logs the password check success
-func (l *Logger) PasswordIsCorrect(userId string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"Password is correct",
-			commonlogger.StatusSuccess,
-			userId,
-		),
+func (l *Logger) PasswordIsCorrect(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"Password is correct",
+		appstructuredlogger.UserId(userId),
 	)
 }
 
 // PasswordIsIncorrect logs the password check failure
-func (l *Logger) PasswordIsIncorrect(userId string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"Password is incorrect",
-			commonlogger.StatusFailed,
-			userId,
-		),
+func (l *Logger) PasswordIsIncorrect(ctx context.Context, userId string) {
+	l.failed(
+		ctx,
+		"Password is incorrect",
+		appstructuredlogger.UserId(userId),
 	)
 }
 
-// FailedToComparePassword logs the password check failure
-func (l *Logger) FailedToComparePassword(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"Failed to compare password",
-			err,
-		),
+// UserIsLocked logs that a locked user tried to log in
+func (l *Logger) UserIsLocked(ctx context.Context, userId string) {
+	l.failed(
+		ctx,
+		"User account is locked",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// PasswordResetIsRequired logs that a user who must reset the password tried to log in
+func (l *Logger) PasswordResetIsRequired(ctx context.Context, userId string) {
+	l.failed(
+		ctx,
+		"Password reset is required",
+		appstructuredlogger.UserId(userId),
 	)
 }
 
+// FailedToComparePassword logs the password check failure
+func (l *Logger) FailedToComparePassword(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to compare password", err)
+}
+
 // UserFoundByUsername logs the user retrieval success
-func (l *Logger) UserFoundByUsername(username string, userId string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User found by username",
-			commonlogger.StatusSuccess,
-			username,
-			userId,
-		),
+func (l *Logger) UserFoundByUsername(ctx context.Context, username string, userId string) {
+	l.success(
+		ctx,
+		"User found by username",
+		appstructuredlogger.Username(username),
+		appstructuredlogger.UserId(userId),
 	)
 }
 
 // UserNotFoundByUsername logs the user retrieval failure
-func (l *Logger) UserNotFoundByUsername(username string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User not found by username",
-			commonlogger.StatusFailed,
-			username,
-		),
+func (l *Logger) UserNotFoundByUsername(ctx context.Context, username string) {
+	l.failed(
+		ctx,
+		"User not found by username",
+		appstructuredlogger.Username(username),
 	)
 }
 
 // UserFoundByUserId logs the user retrieval success
-func (l *Logger) UserFoundByUserId(userId string, username string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User found by user ID",
-			commonlogger.StatusSuccess,
-			userId,
-			username,
-		),
+func (l *Logger) UserFoundByUserId(ctx context.Context, userId string, username string) {
+	l.success(
+		ctx,
+		"User found by user ID",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Username(username),
 	)
 }
 
 // UserNotFoundByUserId logs the user retrieval failure
-func (l *Logger) UserNotFoundByUserId(userId string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User not found by user ID",
-			commonlogger.StatusFailed,
-			userId,
-		),
+func (l *Logger) UserNotFoundByUserId(ctx context.Context, userId string) {
+	l.failed(
+		ctx,
+		"User not found by user ID",
+		appstructuredlogger.UserId(userId),
 	)
 }
 
 // UsernameExists logs the username check success
-func (l *Logger) UsernameExists(username string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"Username exists",
-			commonlogger.StatusSuccess,
-			username,
-		),
+func (l *Logger) UsernameExists(ctx context.Context, username string) {
+	l.success(
+		ctx,
+		"Username exists",
+		appstructuredlogger.Username(username),
 	)
 }
 
 // FailedToCheckIfUsernameExists logs the username check failure
-func (l *Logger) FailedToCheckIfUsernameExists(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"Username exists check failed",
-			err,
-		),
-	)
+func (l *Logger) FailedToCheckIfUsernameExists(ctx context.Context, err error) {
+	l.failure(ctx, "Username exists check failed", err)
 }
 
 // FailedToGetUsernameByUserId logs the username retrieval failure
-func (l *Logger) FailedToGetUsernameByUserId(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"Failed to get username by user ID",
-			err,
-		),
-	)
+func (l *Logger) FailedToGetUsernameByUserId(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to get username by user ID", err)
 }
 
 // FailedToGetUserIdByUsername logs the user ID retrieval failure
-func (l *Logger) FailedToGetUserIdByUsername(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"Failed to get user ID by username",
-			err,
-		),
+func (l *Logger) FailedToGetUserIdByUsername(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to get user ID by username", err)
+}
+
+// UsersFoundInBatch logs the batch lookup of the users
+func (l *Logger) UsersFoundInBatch(ctx context.Context, found int, missing int) {
+	l.success(ctx, "Users found in batch", slog.Int("found", found), slog.Int("missing", missing))
+}
+
+// SearchedUsers logs the users search
+func (l *Logger) SearchedUsers(ctx context.Context, count int) {
+	l.success(ctx, "Searched users", slog.Int("count", count))
+}
+
+// FailedToSearchUsers logs the users search failure
+func (l *Logger) FailedToSearchUsers(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to search users", err)
+}
+
+// GetProfileVisibility logs the profile visibility retrieval
+func (l *Logger) GetProfileVisibility(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"Get profile visibility",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToGetProfileVisibility logs the profile visibility retrieval failure
+func (l *Logger) FailedToGetProfileVisibility(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to get profile visibility", err)
+}
+
+// UpdatedProfileVisibility logs the profile visibility update
+func (l *Logger) UpdatedProfileVisibility(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"Profile visibility updated",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToUpdateProfileVisibility logs the profile visibility update failure
+func (l *Logger) FailedToUpdateProfileVisibility(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to update profile visibility", err)
+}
+
+// GetPreferences logs the preferences retrieval
+func (l *Logger) GetPreferences(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"Get preferences",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToGetPreferences logs the preferences retrieval failure
+func (l *Logger) FailedToGetPreferences(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to get preferences", err)
+}
+
+// UpdatedPreferences logs the preferences update
+func (l *Logger) UpdatedPreferences(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"Preferences updated",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToUpdatePreferences logs the preferences update failure
+func (l *Logger) FailedToUpdatePreferences(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to update preferences", err)
+}
+
+// BlockedUser logs the user block
+func (l *Logger) BlockedUser(ctx context.Context, userId string, blockedId string) {
+	l.success(
+		ctx,
+		"User blocked",
+		appstructuredlogger.UserId(userId),
+		slog.String("blocked_id", blockedId),
+	)
+}
+
+// FailedToBlockUser logs the user block failure
+func (l *Logger) FailedToBlockUser(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to block user", err)
+}
+
+// UnblockedUser logs the user unblock
+func (l *Logger) UnblockedUser(ctx context.Context, userId string, blockedId string) {
+	l.success(
+		ctx,
+		"User unblocked",
+		appstructuredlogger.UserId(userId),
+		slog.String("blocked_id", blockedId),
+	)
+}
+
+// FailedToUnblockUser logs the user unblock failure
+func (l *Logger) FailedToUnblockUser(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to unblock user", err)
+}
+
+// ListedBlockedUsers logs the blocked users listing
+func (l *Logger) ListedBlockedUsers(ctx context.Context, userId string, count int) {
+	l.success(
+		ctx,
+		"Listed blocked users",
+		appstructuredlogger.UserId(userId),
+		slog.Int("count", count),
+	)
+}
+
+// FailedToListBlockedUsers logs the blocked users listing failure
+func (l *Logger) FailedToListBlockedUsers(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to list blocked users", err)
+}
+
+// FailedToCheckIfBlocked logs the block check failure
+func (l *Logger) FailedToCheckIfBlocked(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to check if blocked", err)
+}
+
+// SecondFactorIsCorrect logs the second factor verification
+func (l *Logger) SecondFactorIsCorrect(ctx context.Context, userId string, usedRecoveryCode bool) {
+	l.success(
+		ctx,
+		"Second factor is correct",
+		appstructuredlogger.UserId(userId),
+		slog.Bool("used_recovery_code", usedRecoveryCode),
+	)
+}
+
+// SecondFactorIsIncorrect logs the failed second factor attempt
+func (l *Logger) SecondFactorIsIncorrect(ctx context.Context, userId string) {
+	l.failed(
+		ctx,
+		"Second factor is incorrect",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// SecondFactorIsLocked logs the second factor attempt while it's locked
+func (l *Logger) SecondFactorIsLocked(ctx context.Context, userId string) {
+	l.failed(
+		ctx,
+		"Second factor is locked",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToVerifySecondFactor logs the second factor verification failure
+func (l *Logger) FailedToVerifySecondFactor(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to verify second factor", err)
+}
+
+// EnrolledTOTP logs the TOTP enrollment
+func (l *Logger) EnrolledTOTP(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"TOTP enrolled",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToEnrollTOTP logs the TOTP enrollment failure
+func (l *Logger) FailedToEnrollTOTP(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to enroll TOTP", err)
+}
+
+// ConfirmedTOTP logs the TOTP confirmation
+func (l *Logger) ConfirmedTOTP(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"TOTP confirmed",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToConfirmTOTP logs the TOTP confirmation failure
+func (l *Logger) FailedToConfirmTOTP(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to confirm TOTP", err)
+}
+
+// DisabledTOTP logs the TOTP disabling
+func (l *Logger) DisabledTOTP(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"TOTP disabled",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToDisableTOTP logs the TOTP disabling failure
+func (l *Logger) FailedToDisableTOTP(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to disable TOTP", err)
+}
+
+// StartedPasskeyCeremony logs the start of a passkey registration or assertion
+func (l *Logger) StartedPasskeyCeremony(ctx context.Context, userId string, ceremony string) {
+	l.success(
+		ctx,
+		"Passkey ceremony started",
+		appstructuredlogger.UserId(userId),
+		slog.String("ceremony", ceremony),
+	)
+}
+
+// FailedToStartPasskeyCeremony logs the failure to start a passkey registration or assertion
+func (l *Logger) FailedToStartPasskeyCeremony(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to start passkey ceremony", err)
+}
+
+// RegisteredPasskey logs the passkey registration
+func (l *Logger) RegisteredPasskey(ctx context.Context, userId string, passkeyId string) {
+	l.success(
+		ctx,
+		"Passkey registered",
+		appstructuredlogger.UserId(userId),
+		slog.String("passkey_id", passkeyId),
+	)
+}
+
+// FailedToRegisterPasskey logs the passkey registration failure
+func (l *Logger) FailedToRegisterPasskey(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to register passkey", err)
+}
+
+// PasskeyIsValid logs the passkey assertion
+func (l *Logger) PasskeyIsValid(ctx context.Context, userId string) {
+	l.success(ctx, "Passkey is valid", appstructuredlogger.UserId(userId))
+}
+
+// PasskeyIsCloned logs the passkey assertion rejected because the sign count did not increase
+func (l *Logger) PasskeyIsCloned(ctx context.Context, userId string) {
+	l.failed(ctx, "Passkey may have been cloned", appstructuredlogger.UserId(userId))
+}
+
+// PasskeyIsInvalid logs the passkey response that could not be verified
+func (l *Logger) PasskeyIsInvalid(ctx context.Context, userId string, err error) {
+	l.failed(
+		ctx,
+		"Passkey is invalid",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Error(err),
+	)
+}
+
+// FailedToVerifyPasskey logs the passkey assertion failure
+func (l *Logger) FailedToVerifyPasskey(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to verify passkey", err)
+}
+
+// ListedPasskeys logs the passkeys listing
+func (l *Logger) ListedPasskeys(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"Listed passkeys",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToListPasskeys logs the passkeys listing failure
+func (l *Logger) FailedToListPasskeys(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to list passkeys", err)
+}
+
+// RevokedPasskey logs the passkey revocation
+func (l *Logger) RevokedPasskey(ctx context.Context, userId string, passkeyId string) {
+	l.success(
+		ctx,
+		"Passkey revoked",
+		appstructuredlogger.UserId(userId),
+		slog.String("passkey_id", passkeyId),
+	)
+}
+
+// FailedToRevokePasskey logs the passkey revocation failure
+func (l *Logger) FailedToRevokePasskey(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to revoke passkey", err)
+}
+
+// UserVersionMismatch logs the user update rejected because the user was modified since it was read
+func (l *Logger) UserVersionMismatch(ctx context.Context, userId string, expectedVersion int64) {
+	l.failed(
+		ctx,
+		"User version mismatch",
+		appstructuredlogger.UserId(userId),
+		slog.Int64("expected_version", expectedVersion),
 	)
 }
 
 // UpdatedUser logs the user update
-func (l *Logger) UpdatedUser(userId string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User updated",
-			commonlogger.StatusSuccess,
-			userId,
-		),
+func (l *Logger) UpdatedUser(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"User updated",
+		appstructuredlogger.UserId(userId),
 	)
 }
 
 // FailedToUpdateUser logs the user update failure
-func (l *Logger) FailedToUpdateUser(err error) {
-	l.logger.LogError(commonlogger.NewLogError("User update failed", err))
+func (l *Logger) FailedToUpdateUser(ctx context.Context, err error) {
+	l.failure(ctx, "User update failed", err)
 }
 
 // GetUserPhoneNumber logs the user phone number retrieval
-func (l *Logger) GetUserPhoneNumber(userId string, phoneNumber string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"Fetched user phone number",
-			commonlogger.StatusSuccess,
-			userId,
-			phoneNumber,
-		),
+func (l *Logger) GetUserPhoneNumber(ctx context.Context, userId string, phoneNumber string) {
+	l.success(
+		ctx,
+		"Fetched user phone number",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.PhoneNumber(phoneNumber),
 	)
 }
 
 // FailedToGetUserPhoneNumber logs the user phone number retrieval failure
-func (l *Logger) FailedToGetUserPhoneNumber(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"Failed to get user phone number",
-			err,
-		),
-	)
+func (l *Logger) FailedToGetUserPhoneNumber(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to get user phone number", err)
 }
 
 // GetUserProfile logs the user profile update
-func (l *Logger) GetUserProfile(username string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"Fetched user profile",
-			commonlogger.StatusSuccess,
-			username,
-		),
+func (l *Logger) GetUserProfile(ctx context.Context, username string) {
+	l.success(
+		ctx,
+		"Fetched user profile",
+		appstructuredlogger.Username(username),
 	)
 }
 
 // FailedToGetUserProfile logs the user profile update failure
-func (l *Logger) FailedToGetUserProfile(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"Failed to fetch user profile",
-			err,
-		),
-	)
+func (l *Logger) FailedToGetUserProfile(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to fetch user profile", err)
 }
 
 // GetUserOwnProfile logs the user own profile retrieval
-func (l *Logger) GetUserOwnProfile(userId string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"Fetched user own profile",
-			commonlogger.StatusSuccess,
-			userId,
-		),
+func (l *Logger) GetUserOwnProfile(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"Fetched user own profile",
+		appstructuredlogger.UserId(userId),
 	)
 }
 
 // FailedToGetUserOwnProfile logs the user own profile retrieval failure
-func (l *Logger) FailedToGetUserOwnProfile(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"Failed to fetch user own profile",
-			err,
-		),
-	)
+func (l *Logger) FailedToGetUserOwnProfile(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to fetch user own profile", err)
 }
 
 // UpdatedUsername logs the user username update
-func (l *Logger) UpdatedUsername(userId string, newUsername string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User username updated",
-			commonlogger.StatusSuccess,
-			userId,
-			newUsername,
-		),
+func (l *Logger) UpdatedUsername(ctx context.Context, userId string, newUsername string) {
+	l.success(
+		ctx,
+		"User username updated",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Username(newUsername),
 	)
 }
 
 // FailedToUpdateUsername logs the user username update failure
-func (l *Logger) FailedToUpdateUsername(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"User username update failed",
-			err,
-		),
-	)
+func (l *Logger) FailedToUpdateUsername(ctx context.Context, err error) {
+	l.failure(ctx, "User username update failed", err)
 }
 
 // UpdatedPassword logs the user password update
-func (l *Logger) UpdatedPassword(userId string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User password updated",
-			commonlogger.StatusSuccess,
-			userId,
-		),
+func (l *Logger) UpdatedPassword(ctx context.Context, userId string) {
+	appmetrics.PasswordChangesTotal.Inc()
+	l.success(
+		ctx,
+		"User password updated",
+		appstructuredlogger.UserId(userId),
 	)
 }
 
 // FailedToUpdatePassword logs the user password update failure
-func (l *Logger) FailedToUpdatePassword(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"User password update failed",
-			err,
-		),
-	)
+func (l *Logger) FailedToUpdatePassword(ctx context.Context, err error) {
+	l.failure(ctx, "User password update failed", err)
 }
 
 // FailedToHashPassword logs a failed password hash attempt
-func (l *Logger) FailedToHashPassword(err error) {
-	l.logger.LogError(commonlogger.NewLogError("Failed to hash password", err))
+func (l *Logger) FailedToHashPassword(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to hash password", err)
 }
 
 // UpdatedUserPhoneNumber logs the user phone number update
-func (l *Logger) UpdatedUserPhoneNumber(userId string, newPhoneNumber string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User phone number updated",
-			commonlogger.StatusSuccess,
-			userId,
-			newPhoneNumber,
-		),
+func (l *Logger) UpdatedUserPhoneNumber(ctx context.Context, userId string, newPhoneNumber string) {
+	l.success(
+		ctx,
+		"User phone number updated",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.PhoneNumber(newPhoneNumber),
 	)
 }
 
 // FailedToUpdatePhoneNumber logs the user phone number update failure
-func (l *Logger) FailedToUpdatePhoneNumber(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"User phone number update failed",
-			err,
-		),
-	)
+func (l *Logger) FailedToUpdatePhoneNumber(ctx context.Context, err error) {
+	l.failure(ctx, "User phone number update failed", err)
 }
 
 // DeletedUser logs the user deletion
-func (l *Logger) DeletedUser(userId string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User deleted",
-			commonlogger.StatusSuccess,
-			userId,
-		),
+func (l *Logger) DeletedUser(ctx context.Context, userId string) {
+	appmetrics.DeletionsTotal.Inc()
+	l.success(
+		ctx,
+		"User deleted",
+		appstructuredlogger.UserId(userId),
 	)
 }
 
 // FailedToDeleteUser logs the user deletion failure
-func (l *Logger) FailedToDeleteUser(err error) {
-	l.logger.LogError(commonlogger.NewLogError("User deletion failed", err))
+func (l *Logger) FailedToDeleteUser(ctx context.Context, err error) {
+	l.failure(ctx, "User deletion failed", err)
 }
 
 // UserEmailAlreadyExists logs the user email existence check success
-func (l *Logger) UserEmailAlreadyExists(userId string, email string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User email already exists",
-			commonlogger.StatusFailed,
-			userId,
-			email,
-		),
+func (l *Logger) UserEmailAlreadyExists(ctx context.Context, userId string, email string) {
+	l.failed(
+		ctx,
+		"User email already exists",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
 	)
 }
 
 // UserEmailNotFound logs the user email retrieval failure
-func (l *Logger) UserEmailNotFound(userId string, email string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User email not found",
-			commonlogger.StatusFailed,
-			userId,
-			email,
-		),
+func (l *Logger) UserEmailNotFound(ctx context.Context, userId string, email string) {
+	l.failed(
+		ctx,
+		"User email not found",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
+	)
+}
+
+// UserEmailNotVerified logs the attempt to use an email that is still pending verification
+func (l *Logger) UserEmailNotVerified(ctx context.Context, userId string, email string) {
+	l.failed(
+		ctx,
+		"User email not verified",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
 	)
 }
 
 // AddedUserEmail logs the user email addition
-func (l *Logger) AddedUserEmail(userId string, email string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User email added",
-			commonlogger.StatusSuccess,
-			userId,
-			email,
-		),
+func (l *Logger) AddedUserEmail(ctx context.Context, userId string, email string) {
+	l.success(
+		ctx,
+		"User email added",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
 	)
 }
 
 // FailedToAddUserEmail logs the user email addition failure
-func (l *Logger) FailedToAddUserEmail(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"User email addition failed",
-			err,
-		),
-	)
+func (l *Logger) FailedToAddUserEmail(ctx context.Context, err error) {
+	l.failure(ctx, "User email addition failed", err)
 }
 
 // UpdatedUserPrimaryEmail logs the user primary email change
-func (l *Logger) UpdatedUserPrimaryEmail(userId string, email string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User primary email changed",
-			commonlogger.StatusSuccess,
-			userId,
-			email,
-		),
+func (l *Logger) UpdatedUserPrimaryEmail(ctx context.Context, userId string, email string) {
+	l.success(
+		ctx,
+		"User primary email changed",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
 	)
 }
 
 // FailedToUpdateUserPrimaryEmail logs the user primary email change failure
-func (l *Logger) FailedToUpdateUserPrimaryEmail(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"User primary email change failed",
-			err,
-		),
-	)
+func (l *Logger) FailedToUpdateUserPrimaryEmail(ctx context.Context, err error) {
+	l.failure(ctx, "User primary email change failed", err)
 }
 
 // DeletedUserEmail logs the user email deletion
-func (l *Logger) DeletedUserEmail(userId string, email string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"User email deleted",
-			commonlogger.StatusSuccess,
-			userId,
-			email,
-		),
+func (l *Logger) DeletedUserEmail(ctx context.Context, userId string, email string) {
+	l.success(
+		ctx,
+		"User email deleted",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
 	)
 }
 
 // FailedToDeleteUserEmail logs the user email deletion failure
-func (l *Logger) FailedToDeleteUserEmail(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"User email deletion failed",
-			err,
-		),
-	)
+func (l *Logger) FailedToDeleteUserEmail(ctx context.Context, err error) {
+	l.failure(ctx, "User email deletion failed", err)
 }
 
 // GetUserPrimaryEmail logs the user primary email retrieval
-func (l *Logger) GetUserPrimaryEmail(userId string, email string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"Fetched user primary email",
-			commonlogger.StatusSuccess,
-			userId,
-			email,
-		),
+func (l *Logger) GetUserPrimaryEmail(ctx context.Context, userId string, email string) {
+	l.success(
+		ctx,
+		"Fetched user primary email",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
 	)
 }
 
 // FailedToGetPrimaryEmail logs the user primary email retrieval failure
-func (l *Logger) FailedToGetPrimaryEmail(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"Failed to fetch user primary email",
-			err,
-		),
-	)
+func (l *Logger) FailedToGetPrimaryEmail(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to fetch user primary email", err)
 }
 
 // GetUserActiveEmails logs the user active emails retrieval
-func (l *Logger) GetUserActiveEmails(userId string) {
-	l.logger.LogMessage(
-		commonlogger.NewLogMessage(
-			"Fetched user active emails",
-			commonlogger.StatusSuccess,
-			userId,
-		),
+func (l *Logger) GetUserActiveEmails(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"Fetched user active emails",
+		appstructuredlogger.UserId(userId),
 	)
 }
 
 // FailedToGetActiveEmails logs the user active emails retrieval failure
-func (l *Logger) FailedToGetActiveEmails(err error) {
-	l.logger.LogError(
-		commonlogger.NewLogError(
-			"Failed to fetch user active emails",
-			err,
-		),
+func (l *Logger) FailedToGetActiveEmails(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to fetch user active emails", err)
+}
+
+// EmailsAreDisabled logs a request that needs to send an email while the emails are not enabled
+func (l *Logger) EmailsAreDisabled(ctx context.Context) {
+	l.failed(ctx, "Emails are disabled")
+}
+
+// SentResetPassword logs that the password reset link was sent to the user
+func (l *Logger) SentResetPassword(ctx context.Context, userId string) {
+	l.success(
+		ctx,
+		"Password reset link sent",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// ResetPasswordNotSent logs that the password reset link was not sent, since the user doesn't exist or has no
+// verified primary email
+func (l *Logger) ResetPasswordNotSent(ctx context.Context, username string) {
+	l.failed(
+		ctx,
+		"Password reset link not sent",
+		appstructuredlogger.Username(username),
+	)
+}
+
+// FailedToSendResetPassword logs the password reset link sending failure
+func (l *Logger) FailedToSendResetPassword(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to send password reset link", err)
+}
+
+// ResetPasswordTokenIsInvalid logs a password reset with an invalid or expired token
+func (l *Logger) ResetPasswordTokenIsInvalid(ctx context.Context) {
+	l.failed(ctx, "Password reset token is invalid")
+}
+
+// PasswordReset logs the user password reset
+func (l *Logger) PasswordReset(ctx context.Context, userId string) {
+	appmetrics.PasswordChangesTotal.Inc()
+	l.success(
+		ctx,
+		"User password reset",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FailedToResetPassword logs the user password reset failure
+func (l *Logger) FailedToResetPassword(ctx context.Context, err error) {
+	l.failure(ctx, "User password reset failed", err)
+}
+
+// SentVerificationEmail logs the email verification link sent to the user
+func (l *Logger) SentVerificationEmail(ctx context.Context, userId string, email string) {
+	l.success(
+		ctx,
+		"Email verification link sent",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
+	)
+}
+
+// PendingEmailNotFound logs that the user has no pending email with the given address
+func (l *Logger) PendingEmailNotFound(ctx context.Context, userId string, email string) {
+	l.failed(
+		ctx,
+		"Pending user email not found",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
 	)
 }
+
+// FailedToSendVerificationEmail logs the failure to send the email verification link
+func (l *Logger) FailedToSendVerificationEmail(ctx context.Context, err error) {
+	l.failure(ctx, "Failed to send email verification link", err)
+}
+
+// VerifyEmailTokenIsInvalid logs an email verification with an invalid or expired token
+func (l *Logger) VerifyEmailTokenIsInvalid(ctx context.Context, userId string) {
+	l.failed(
+		ctx,
+		"Email verification token is invalid",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// VerifiedEmail logs the user email verification
+func (l *Logger) VerifiedEmail(ctx context.Context, userId string, email string) {
+	l.success(
+		ctx,
+		"User email verified",
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Email(email),
+	)
+}
+
+// FailedToVerifyEmail logs the user email verification failure
+func (l *Logger) FailedToVerifyEmail(ctx context.Context, err error) {
+	l.failure(ctx, "User email verification failed", err)
+}
@@ -0,0 +1,163 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	commonflag "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/config/flag"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+const (
+	testUserId      = "6716f0a2c4b1e8a9d3f20b11"
+	testEmail       = "jane.doe@example.com"
+	testPhoneNumber = "+584121234567"
+	testFirstName   = "Jane"
+	testLastName    = "Doe"
+)
+
+// newTestLogger creates a user server logger that writes through the redacting handler into the buffer
+func newTestLogger(t *testing.T, buffer *bytes.Buffer) *Logger {
+	t.Helper()
+
+	logger, err := NewLogger(
+		slog.New(
+			appstructuredlogger.NewHandler(
+				slog.NewJSONHandler(
+					buffer,
+					&slog.HandlerOptions{
+						Level:       slog.LevelDebug,
+						ReplaceAttr: appstructuredlogger.ReplaceAttr,
+					},
+				),
+			),
+		),
+	)
+	if err != nil {
+		t.Fatalf("failed to create the logger: %v", err)
+	}
+	return logger
+}
+
+// setTestRedaction sets the redaction policy of the mode and restores the prod one after the test
+func setTestRedaction(t *testing.T, mode string) {
+	t.Helper()
+
+	if err := appstructuredlogger.SetRedaction(
+		commonflag.NewModeFlag(mode, []string{commonflag.ModeDev, commonflag.ModeProd}),
+		"",
+		"",
+		"",
+	); err != nil {
+		t.Fatalf("failed to set the redaction policy: %v", err)
+	}
+	t.Cleanup(
+		func() {
+			_ = appstructuredlogger.SetRedaction(
+				commonflag.NewModeFlag(
+					commonflag.ModeProd,
+					[]string{commonflag.ModeDev, commonflag.ModeProd},
+				),
+				"",
+				"",
+				"",
+			)
+		},
+	)
+}
+
+func TestLoggerRedaction(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		log  func(logger *Logger)
+		pii  []string
+	}{
+		{
+			name: "GetUserPhoneNumber",
+			log: func(logger *Logger) {
+				logger.GetUserPhoneNumber(ctx, testUserId, testPhoneNumber)
+			},
+			pii: []string{testPhoneNumber},
+		},
+		{
+			name: "UpdatedUserPhoneNumber",
+			log: func(logger *Logger) {
+				logger.UpdatedUserPhoneNumber(ctx, testUserId, testPhoneNumber)
+			},
+			pii: []string{testPhoneNumber},
+		},
+		{
+			name: "AddedUserEmail",
+			log: func(logger *Logger) {
+				logger.AddedUserEmail(ctx, testUserId, testEmail)
+			},
+			pii: []string{testEmail},
+		},
+		{
+			name: "DeletedUserEmail",
+			log: func(logger *Logger) {
+				logger.DeletedUserEmail(ctx, testUserId, testEmail)
+			},
+			pii: []string{testEmail},
+		},
+		{
+			name: "Names",
+			log: func(logger *Logger) {
+				logger.success(
+					ctx,
+					"User names",
+					appstructuredlogger.UserId(testUserId),
+					appstructuredlogger.FirstName(testFirstName),
+					appstructuredlogger.LastName(testLastName),
+				)
+			},
+			pii: []string{testFirstName, testLastName},
+		},
+		{
+			name: "FreeTextError",
+			log: func(logger *Logger) {
+				logger.FailedToAddUserEmail(
+					ctx,
+					errors.New("duplicate key "+testEmail+" for "+testPhoneNumber),
+				)
+			},
+			pii: []string{testEmail, testPhoneNumber},
+		},
+	}
+
+	for _, mode := range []string{commonflag.ModeProd, commonflag.ModeDev} {
+		for _, test := range tests {
+			t.Run(
+				mode+"/"+test.name, func(t *testing.T) {
+					setTestRedaction(t, mode)
+
+					var buffer bytes.Buffer
+					test.log(newTestLogger(t, &buffer))
+
+					output := buffer.String()
+					if output == "" {
+						t.Fatal("nothing was logged")
+					}
+					if !strings.Contains(output, testUserId) && test.name != "FreeTextError" {
+						t.Errorf("the user ID was not logged: %s", output)
+					}
+
+					for _, value := range test.pii {
+						logged := strings.Contains(output, value)
+						if mode == commonflag.ModeProd && logged {
+							t.Errorf("%q was logged in plaintext: %s", value, output)
+						}
+						if mode == commonflag.ModeDev && !logged {
+							t.Errorf("%q was not logged in plaintext: %s", value, output)
+						}
+					}
+				},
+			)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package user
+
+import (
+	"encoding/json"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	appmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb/user"
+	apppasskey "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/passkey"
+	pbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/user"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toWebAuthnCredential converts the stored passkey to the WebAuthn credential
+func toWebAuthnCredential(credential *appmongodbuser.UserPasskeyCredential) webauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, len(credential.Transports))
+	for i, transport := range credential.Transports {
+		transports[i] = protocol.AuthenticatorTransport(transport)
+	}
+
+	return webauthn.Credential{
+		ID:              credential.CredentialID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transport:       transports,
+		Flags: webauthn.CredentialFlags{
+			BackupEligible: credential.BackupEligible,
+			BackupState:    credential.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:       credential.AAGUID,
+			SignCount:    credential.SignCount,
+			CloneWarning: credential.CloneWarning,
+		},
+	}
+}
+
+// fromWebAuthnCredential converts the verified WebAuthn credential to the passkey to store
+func fromWebAuthnCredential(
+	userId string,
+	credential *webauthn.Credential,
+	name string,
+) (*appmongodbuser.UserPasskeyCredential, error) {
+	userObjectId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	transports := make([]string, len(credential.Transport))
+	for i, transport := range credential.Transport {
+		transports[i] = string(transport)
+	}
+
+	return &appmongodbuser.UserPasskeyCredential{
+		UserID:          userObjectId,
+		CredentialID:    credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		AAGUID:          credential.Authenticator.AAGUID,
+		SignCount:       credential.Authenticator.SignCount,
+		Transports:      transports,
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+		Name:            name,
+	}, nil
+}
+
+// toPasskey converts the stored passkey to the gRPC passkey, without its key material
+func toPasskey(credential *appmongodbuser.UserPasskeyCredential) *pbuser.Passkey {
+	passkey := &pbuser.Passkey{
+		Id:         credential.ID.Hex(),
+		Name:       credential.Name,
+		Transports: credential.Transports,
+		CreatedAt:  timestamppb.New(credential.CreatedAt),
+	}
+	if !credential.LastUsedAt.IsZero() {
+		passkey.LastUsedAt = timestamppb.New(credential.LastUsedAt)
+	}
+	return passkey
+}
+
+// getPasskeyUser gets the user whose passkeys take part in a ceremony
+func (s *Server) getPasskeyUser(
+	ctx context.Context,
+	userId string,
+	username string,
+) (*apppasskey.User, []*appmongodbuser.UserPasskeyCredential, error) {
+	credentials, err := s.userDatabase.GetUserPasskeyCredentials(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := &apppasskey.User{ID: userId, Username: username}
+	for _, credential := range credentials {
+		user.Credentials = append(user.Credentials, toWebAuthnCredential(credential))
+	}
+	return user, credentials, nil
+}
+
+// startPasskeySession stores the data of a started ceremony, and returns its ID and the options for the client
+func (s *Server) startPasskeySession(
+	ctx context.Context,
+	userId string,
+	ceremony string,
+	session *webauthn.SessionData,
+	options interface{},
+) (sessionId string, encodedOptions string, err error) {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return "", "", err
+	}
+	optionsData, err := json.Marshal(options)
+	if err != nil {
+		return "", "", err
+	}
+
+	sessionId, err = s.userDatabase.InsertUserPasskeySession(ctx, userId, ceremony, data, s.passkeySessionTTL)
+	if err != nil {
+		return "", "", err
+	}
+	return sessionId, string(optionsData), nil
+}
+
+// checkPasskeysEnabled returns a failed precondition error if the relying party is not configured
+func (s *Server) checkPasskeysEnabled() error {
+	if s.webAuthn == nil {
+		return status.Error(codes.FailedPrecondition, PasskeysAreDisabled)
+	}
+	return nil
+}
+
+// finishPasskeySession consumes a started ceremony, and returns its user ID and data. It returns
+// mongo.ErrNoDocuments if the session doesn't exist or has expired
+func (s *Server) finishPasskeySession(
+	ctx context.Context,
+	sessionId string,
+	ceremony string,
+) (userId string, session *webauthn.SessionData, err error) {
+	userSession, err := s.userDatabase.ConsumeUserPasskeySession(ctx, sessionId, ceremony)
+	if err != nil {
+		return "", nil, err
+	}
+
+	session = &webauthn.SessionData{}
+	if err = json.Unmarshal(userSession.Data, session); err != nil {
+		return "", nil, err
+	}
+	return userSession.UserID.Hex(), session, nil
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	commonbcrypt "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/bcrypt"
+	apptracing "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/tracing"
+	"golang.org/x/net/context"
+)
+
+// hashPassword hashes the password inside its own span
+func hashPassword(ctx context.Context, password string) (string, error) {
+	_, span := apptracing.StartSpan(ctx, "bcrypt.HashPassword")
+	defer span.End()
+
+	return commonbcrypt.HashPassword(password)
+}
+
+// checkPasswordHash compares the password with the hash inside its own span
+func checkPasswordHash(ctx context.Context, password string, hash string) bool {
+	_, span := apptracing.StartSpan(ctx, "bcrypt.CheckPasswordHash")
+	defer span.End()
+
+	return commonbcrypt.CheckPasswordHash(password, hash)
+}
@@ -0,0 +1,39 @@
+package user
+
+import (
+	appmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb/user"
+	apppreferences "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/preferences"
+	pbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/user"
+)
+
+// toPreferences converts the stored preferences to the gRPC preferences
+func toPreferences(preferences *appmongodbuser.UserPreferences) *pbuser.Preferences {
+	return &pbuser.Preferences{
+		Locale:   preferences.Locale,
+		Timezone: preferences.Timezone,
+		Currency: preferences.Currency,
+		Notifications: &pbuser.NotificationPreferences{
+			Email: preferences.Notifications.Email,
+			Sms:   preferences.Notifications.SMS,
+			Push:  preferences.Notifications.Push,
+		},
+	}
+}
+
+// fromPreferences gets the values of the preference keys updated by the field mask paths
+func fromPreferences(preferences *pbuser.Preferences, paths []string) map[string]interface{} {
+	values := map[string]interface{}{
+		apppreferences.Locale:             preferences.GetLocale(),
+		apppreferences.Timezone:           preferences.GetTimezone(),
+		apppreferences.Currency:           preferences.GetCurrency(),
+		apppreferences.NotificationsEmail: preferences.GetNotifications().GetEmail(),
+		apppreferences.NotificationsSMS:   preferences.GetNotifications().GetSms(),
+		apppreferences.NotificationsPush:  preferences.GetNotifications().GetPush(),
+	}
+
+	update := make(map[string]interface{})
+	for _, key := range apppreferences.Keys(paths) {
+		update[key] = values[key]
+	}
+	return update
+}
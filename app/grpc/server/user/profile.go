@@ -0,0 +1,90 @@
+package user
+
+import (
+	appprofile "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/profile"
+	pbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"strings"
+)
+
+// profileFieldValue gets the value of a profile field from the update request, which is nil if the field is empty
+func profileFieldValue(request *pbuser.UpdateUserRequest, field string) interface{} {
+	var value string
+	switch field {
+	case appprofile.Birthdate:
+		if request.GetBirthdate() == nil {
+			return nil
+		}
+		return request.GetBirthdate().AsTime()
+	case appprofile.SocialLinks:
+		if len(request.GetSocialLinks().GetUrls()) == 0 {
+			return nil
+		}
+		return request.GetSocialLinks().GetUrls()
+	case appprofile.FirstName:
+		value = request.GetFirstName()
+	case appprofile.LastName:
+		value = request.GetLastName()
+	case appprofile.Bio:
+		value = request.GetBio()
+	case appprofile.Location:
+		value = request.GetLocation()
+	case appprofile.Website:
+		value = request.GetWebsite()
+	case appprofile.Pronouns:
+		value = request.GetPronouns()
+	case appprofile.DisplayName:
+		value = request.GetDisplayName()
+	}
+
+	if value = strings.TrimSpace(value); value == "" {
+		return nil
+	}
+	return value
+}
+
+// updateProfileFields gets the profile fields to set and to clear from the update request. A cleared field is always
+// unset. With an update mask, each path is set to the request value or cleared if it's empty. Without it, only the
+// fields present in the request are updated, and the names and birthdate are skipped if they're empty
+func updateProfileFields(request *pbuser.UpdateUserRequest) (set bson.M, unset bson.M) {
+	set, unset = bson.M{}, bson.M{}
+
+	// Check if the update mask is set
+	if request.GetUpdateMask() != nil {
+		for _, path := range request.GetUpdateMask().GetPaths() {
+			if value := profileFieldValue(request, path); value != nil {
+				set[path] = value
+			} else {
+				unset[path] = ""
+			}
+		}
+		return set, unset
+	}
+
+	// Iterate over the request names and birthdate, where an empty value is skipped
+	for _, field := range []string{appprofile.FirstName, appprofile.LastName, appprofile.Birthdate} {
+		if value := profileFieldValue(request, field); value != nil {
+			set[field] = value
+		}
+	}
+
+	// Iterate over the request profile fields, where an empty value clears the field
+	for field, present := range map[string]bool{
+		appprofile.Bio:         request.Bio != nil,
+		appprofile.Location:    request.Location != nil,
+		appprofile.Website:     request.Website != nil,
+		appprofile.Pronouns:    request.Pronouns != nil,
+		appprofile.DisplayName: request.DisplayName != nil,
+		appprofile.SocialLinks: request.SocialLinks != nil,
+	} {
+		if !present {
+			continue
+		}
+		if value := profileFieldValue(request, field); value != nil {
+			set[field] = value
+		} else {
+			unset[field] = ""
+		}
+	}
+	return set, unset
+}
@@ -2,23 +2,29 @@ package user
 
 import (
 	"errors"
-	commonbcrypt "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/bcrypt"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
 	commonjwtvalidator "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt/validator"
 	commonuser "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb/model/user"
-	commongrpcclientctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/client/context"
 	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
 	pbauth "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/compiled/pixel_plaza/auth"
-	pbuser "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/compiled/pixel_plaza/user"
 	appmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb/user"
+	appgrpcclientctx "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/client/context"
 	userservervalidator "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/user/validator"
-	"go.mongodb.org/mongo-driver/bson"
+	appmail "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/mail"
+	appmfa "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/mfa"
+	apppasskey "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/passkey"
+	appvisibility "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/visibility"
+	pbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/user"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
 	"time"
 )
 
@@ -29,6 +35,11 @@ type Server struct {
 	logger             *Logger
 	validator          *userservervalidator.Validator
 	jwtValidatorLogger *commonjwtvalidator.Logger
+	webAuthn           *webauthn.WebAuthn
+	passkeySessionTTL  time.Duration
+	mailer             *appmail.Mailer
+	resetPasswordTTL   time.Duration
+	verifyEmailTTL     time.Duration
 	pbuser.UnimplementedUserServer
 }
 
@@ -39,6 +50,11 @@ func NewServer(
 	logger *Logger,
 	validator *userservervalidator.Validator,
 	jwtValidatorLogger *commonjwtvalidator.Logger,
+	webAuthn *webauthn.WebAuthn,
+	passkeySessionTTL time.Duration,
+	mailer *appmail.Mailer,
+	resetPasswordTTL time.Duration,
+	verifyEmailTTL time.Duration,
 ) *Server {
 	return &Server{
 		userDatabase:       userDatabase,
@@ -46,6 +62,11 @@ func NewServer(
 		logger:             logger,
 		validator:          validator,
 		jwtValidatorLogger: jwtValidatorLogger,
+		webAuthn:           webAuthn,
+		passkeySessionTTL:  passkeySessionTTL,
+		mailer:             mailer,
+		resetPasswordTTL:   resetPasswordTTL,
+		verifyEmailTTL:     verifyEmailTTL,
 	}
 }
 
@@ -55,28 +76,30 @@ func (s *Server) SignUp(
 	request *pbuser.SignUpRequest,
 ) (response *pbuser.SignUpResponse, err error) {
 	// Validate the request
-	if err = s.validator.ValidateSignUpRequest(request); err != nil {
-		s.logger.FailedToSignUp(err)
+	if err = s.validator.ValidateSignUpRequest(ctx, request); err != nil {
+		s.logger.FailedToSignUp(ctx, err)
 		return nil, err
 	}
 
 	// Hash the password
-	hashedPassword, err := commonbcrypt.HashPassword(request.GetPassword())
+	hashedPassword, err := hashPassword(ctx, request.GetPassword())
 	if err != nil {
-		s.logger.FailedToHashPassword(err)
-		return nil, InternalServerError
+		s.logger.FailedToHashPassword(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Create a new user
 	currentTime := time.Now()
 	userId := primitive.NewObjectID()
-	newUser := commonuser.User{
-		ID:             userId,
-		Username:       request.GetUsername(),
-		FirstName:      request.GetFirstName(),
-		LastName:       request.GetLastName(),
-		HashedPassword: hashedPassword,
-		JoinedAt:       currentTime,
+	newUser := appmongodbuser.User{
+		User: commonuser.User{
+			ID:             userId,
+			Username:       request.GetUsername(),
+			FirstName:      request.GetFirstName(),
+			LastName:       request.GetLastName(),
+			HashedPassword: hashedPassword,
+			JoinedAt:       currentTime,
+		},
 	}
 
 	// Add the birthdate if it exists
@@ -84,7 +107,7 @@ func (s *Server) SignUp(
 		newUser.Birthdate = request.GetBirthdate().AsTime()
 	}
 
-	// Create the user email
+	// Create the user email, which is the primary email but stays pending until it is verified
 	newUserEmail := commonuser.UserEmail{
 		ID:         primitive.NewObjectID(),
 		UserID:     userId,
@@ -103,16 +126,17 @@ func (s *Server) SignUp(
 
 	// Insert the user into the user
 	if err = s.userDatabase.InsertUser(
+		ctx,
 		&newUser,
 		&newUserEmail,
 		&newUserPhoneNumber,
 	); err != nil {
-		s.logger.FailedToSignUp(err)
-		return nil, InternalServerError
+		s.logger.FailedToSignUp(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// User signed up successfully
-	s.logger.SignedUp(userId.Hex(), request.GetUsername())
+	s.logger.SignedUp(ctx, userId.Hex(), request.GetUsername())
 
 	return &pbuser.SignUpResponse{
 		Message: SignedUp,
@@ -125,29 +149,30 @@ func (s *Server) IsPasswordCorrect(
 ) (response *pbuser.IsPasswordCorrectResponse, err error) {
 	// Validate the request
 	if err = s.validator.ValidateIsPasswordCorrectRequest(request); err != nil {
-		s.logger.FailedToComparePassword(err)
+		s.logger.FailedToComparePassword(ctx, err)
 		return nil, err
 	}
 
 	// Get the user ID and hashed password by username
 	user, err := s.userDatabase.GetUserHashedPassword(
-		context.Background(), request.GetUsername(),
+		ctx, request.GetUsername(),
 	)
 	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
-		s.logger.FailedToComparePassword(err)
-		return nil, InternalServerError
+		s.logger.FailedToComparePassword(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Check if the user doesn't exist
 	if err != nil {
 		// User not found by username
-		s.logger.UserNotFoundByUsername(request.GetUsername())
+		s.logger.UserNotFoundByUsername(ctx, request.GetUsername())
 
 		return nil, status.Error(codes.NotFound, FailedToComparePassword)
 	}
 
 	// Check if the password matches
-	matches := commonbcrypt.CheckPasswordHash(
+	matches := checkPasswordHash(
+		ctx,
 		request.GetPassword(),
 		user.HashedPassword,
 	)
@@ -158,170 +183,1256 @@ func (s *Server) IsPasswordCorrect(
 	// Check if the password doesn't match or the user doesn't exist
 	if !matches {
 		// User checked password unsuccessfully
-		s.logger.PasswordIsIncorrect(userId)
+		s.logger.PasswordIsIncorrect(ctx, userId)
 
 		return nil, status.Error(codes.InvalidArgument, FailedToComparePassword)
 	}
 
-	// User checked password successfully
-	s.logger.PasswordIsCorrect(userId)
+	// Check if the user account is locked by the support staff
+	if !user.LockedAt.IsZero() {
+		s.logger.UserIsLocked(ctx, userId)
+		return nil, status.Error(codes.PermissionDenied, UserIsLocked)
+	}
+
+	// Check if the user must reset the password before logging in
+	if user.PasswordResetRequired {
+		s.logger.PasswordResetIsRequired(ctx, userId)
+		return nil, status.Error(codes.FailedPrecondition, PasswordResetIsRequired)
+	}
 
+	// Check if the user must complete the login with a second factor
+	secondFactorRequired, err := s.userDatabase.IsUserTOTPEnabled(ctx, userId)
+	if err != nil {
+		s.logger.FailedToComparePassword(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// User checked password successfully
+	s.logger.PasswordIsCorrect(ctx, userId)
+
+	// Reject the login until the second factor is verified, with the user ID to verify it with
+	if secondFactorRequired {
+		st, err := status.New(codes.FailedPrecondition, SecondFactorIsRequired).WithDetails(
+			&errdetails.ErrorInfo{
+				Reason:   SecondFactorRequiredReason,
+				Domain:   SecondFactorRequiredDomain,
+				Metadata: map[string]string{UserIdMetadataKey: userId},
+			},
+		)
+		if err != nil {
+			return nil, InternalError(ctx, err)
+		}
+		return nil, st.Err()
+	}
 	return &pbuser.IsPasswordCorrectResponse{
 		Message: PasswordIsCorrect,
 		UserId:  userId,
 	}, nil
 }
 
+// VerifySecondFactor checks the TOTP or recovery code of a user whose password is correct, for the auth service
+func (s *Server) VerifySecondFactor(
+	ctx context.Context,
+	request *pbuser.VerifySecondFactorRequest,
+) (response *pbuser.VerifySecondFactorResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateVerifySecondFactorRequest(request); err != nil {
+		s.logger.FailedToVerifySecondFactor(ctx, err)
+		return nil, err
+	}
+
+	// Get the user, which must not be deleted nor locked by the support staff
+	userId := request.GetUserId()
+	user, err := s.userDatabase.FindAdminUserByUserId(ctx, userId)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.FailedToVerifySecondFactor(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err != nil || !user.DeletedAt.IsZero() {
+		s.logger.UserNotFoundByUserId(ctx, userId)
+		return nil, status.Error(codes.NotFound, NotFoundByUserId)
+	}
+	if !user.LockedAt.IsZero() {
+		s.logger.UserIsLocked(ctx, userId)
+		return nil, status.Error(codes.PermissionDenied, UserIsLocked)
+	}
+
+	// Check if the user must reset the password before logging in
+	if user.PasswordResetRequired {
+		s.logger.PasswordResetIsRequired(ctx, userId)
+		return nil, status.Error(codes.FailedPrecondition, PasswordResetIsRequired)
+	}
+
+	// Get the user's confirmed TOTP
+	userTOTP, err := s.userDatabase.GetUserTOTP(ctx, userId)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.FailedToVerifySecondFactor(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err != nil || userTOTP.ConfirmedAt.IsZero() {
+		return nil, status.Error(codes.FailedPrecondition, TOTPIsNotEnabled)
+	}
+
+	// Check the code
+	_, usedRecoveryCode, err := s.verifySecondFactor(ctx, userId, userTOTP, request.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// Second factor verified successfully
+	remainingRecoveryCodes := len(userTOTP.RecoveryCodes)
+	if usedRecoveryCode {
+		remainingRecoveryCodes--
+	}
+	s.logger.SecondFactorIsCorrect(ctx, userId, usedRecoveryCode)
+
+	return &pbuser.VerifySecondFactorResponse{
+		Message:                VerifiedSecondFactor,
+		UserId:                 userId,
+		RemainingRecoveryCodes: int32(remainingRecoveryCodes),
+	}, nil
+}
+
 // UsernameExists checks if the username exists
 func (s *Server) UsernameExists(
 	ctx context.Context,
 	request *pbuser.UsernameExistsRequest,
 ) (response *pbuser.UsernameExistsResponse, err error) {
 	// Validate the request
-	if err = s.validator.ValidateUsernameExistsRequest(request); err != nil {
-		s.logger.FailedToCheckIfUsernameExists(err)
+	if err = s.validator.ValidateUsernameExistsRequest(request); err != nil {
+		s.logger.FailedToCheckIfUsernameExists(ctx, err)
+		return nil, err
+	}
+
+	// Check if the username exists
+	exists, err := s.userDatabase.UsernameExists(
+		ctx,
+		request.GetUsername(),
+	)
+	if err != nil {
+		s.logger.FailedToCheckIfUsernameExists(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Check if the username doesn't exist
+	if !exists {
+		// Username does not exist
+		s.logger.UserNotFoundByUsername(ctx, request.GetUsername())
+
+		return nil, status.Error(codes.NotFound, NotFoundByUsername)
+	}
+
+	// User found by username
+	s.logger.UsernameExists(ctx, request.GetUsername())
+
+	return &pbuser.UsernameExistsResponse{
+		Message: FoundByUsername,
+	}, nil
+}
+
+// GetUserIdByUsername gets the user's ID by username
+func (s *Server) GetUserIdByUsername(
+	ctx context.Context,
+	request *pbuser.GetUserIdByUsernameRequest,
+) (response *pbuser.GetUserIdByUsernameResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateGetUserIdByUsernameRequest(request); err != nil {
+		s.logger.FailedToGetUserIdByUsername(ctx, err)
+		return nil, err
+	}
+
+	// Get the user ID by username
+	userId, err := s.userDatabase.GetUserIdByUsername(
+		ctx,
+		request.GetUsername(),
+	)
+	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
+		s.logger.FailedToGetUserIdByUsername(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Check if the username doesn't exist
+	if err != nil {
+		// Username does not exist
+		s.logger.UserNotFoundByUsername(ctx, request.GetUsername())
+
+		return nil, status.Error(codes.NotFound, NotFoundByUsername)
+	}
+
+	// User found by username
+	s.logger.UserFoundByUsername(ctx, request.GetUsername(), userId)
+
+	return &pbuser.GetUserIdByUsernameResponse{
+		Message: FoundByUsername,
+		UserId:  userId,
+	}, nil
+}
+
+// GetUsernameByUserId gets the user's username by ID
+func (s *Server) GetUsernameByUserId(
+	ctx context.Context,
+	request *pbuser.GetUsernameByUserIdRequest,
+) (response *pbuser.GetUsernameByUserIdResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateGetUsernameByUserIdRequest(request); err != nil {
+		s.logger.FailedToGetUsernameByUserId(ctx, err)
+		return nil, err
+	}
+
+	// Get the username by user ID
+	username, err := s.userDatabase.GetUsernameByUserId(
+		ctx,
+		request.GetUserId(),
+	)
+	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
+		s.logger.FailedToGetUsernameByUserId(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Check if the user ID doesn't exist
+	if err != nil {
+		// User ID does not exist
+		s.logger.UserNotFoundByUserId(ctx, request.GetUserId())
+
+		return nil, status.Error(codes.NotFound, NotFoundByUserId)
+	}
+
+	// User found by user ID
+	s.logger.UserFoundByUsername(ctx, request.GetUserId(), username)
+
+	return &pbuser.GetUsernameByUserIdResponse{
+		Message:  FoundByUserId,
+		Username: username,
+	}, nil
+}
+
+// BatchGetUsernamesByUserIds gets the users' usernames by ID in a single query, reporting the user IDs not found
+func (s *Server) BatchGetUsernamesByUserIds(
+	ctx context.Context,
+	request *pbuser.BatchGetUsernamesByUserIdsRequest,
+) (response *pbuser.BatchGetUsernamesByUserIdsResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateBatchGetUsernamesByUserIdsRequest(request); err != nil {
+		s.logger.FailedToGetUsernameByUserId(ctx, err)
+		return nil, err
+	}
+
+	// Get the usernames by user ID
+	usernames, missing, err := s.userDatabase.GetUsernamesByUserIds(ctx, request.GetUserIds())
+	if err != nil {
+		s.logger.FailedToGetUsernameByUserId(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Users found by user ID
+	s.logger.UsersFoundInBatch(ctx, len(usernames), len(missing))
+
+	return &pbuser.BatchGetUsernamesByUserIdsResponse{
+		Message:        FoundByUserIds,
+		Usernames:      usernames,
+		MissingUserIds: missing,
+	}, nil
+}
+
+// BatchGetUserIdsByUsernames gets the users' IDs by username in a single query, reporting the usernames not found
+func (s *Server) BatchGetUserIdsByUsernames(
+	ctx context.Context,
+	request *pbuser.BatchGetUserIdsByUsernamesRequest,
+) (response *pbuser.BatchGetUserIdsByUsernamesResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateBatchGetUserIdsByUsernamesRequest(request); err != nil {
+		s.logger.FailedToGetUserIdByUsername(ctx, err)
+		return nil, err
+	}
+
+	// Get the user IDs by username
+	userIds, missing, err := s.userDatabase.GetUserIdsByUsernames(ctx, request.GetUsernames())
+	if err != nil {
+		s.logger.FailedToGetUserIdByUsername(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Users found by username
+	s.logger.UsersFoundInBatch(ctx, len(userIds), len(missing))
+
+	return &pbuser.BatchGetUserIdsByUsernamesResponse{
+		Message:          FoundByUsernames,
+		UserIds:          userIds,
+		MissingUsernames: missing,
+	}, nil
+}
+
+// SearchUsers searches the users by username prefix and by first and last name, returning the public profile
+// fields of a page of users
+func (s *Server) SearchUsers(
+	ctx context.Context,
+	request *pbuser.SearchUsersRequest,
+) (response *pbuser.SearchUsersResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateSearchUsersRequest(request); err != nil {
+		s.logger.FailedToSearchUsers(ctx, err)
+		return nil, err
+	}
+
+	// Get the page size and the cursor of the previous page
+	pageSize := int64(userservervalidator.DefaultSearchPageSize)
+	if request.PageSize != nil {
+		pageSize = int64(request.GetPageSize())
+	}
+	var cursor string
+	if request.PageToken != nil {
+		cursor, _ = appmongodbuser.DecodeSearchUsersCursor(request.GetPageToken())
+	}
+
+	// Search the users, matching only the names visible to the caller
+	authenticated := isAuthenticated(ctx)
+	users, nextCursor, err := s.userDatabase.SearchUsers(
+		ctx,
+		strings.TrimSpace(request.GetQuery()),
+		authenticated,
+		pageSize,
+		cursor,
+	)
+	if err != nil {
+		s.logger.FailedToSearchUsers(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Users searched successfully
+	s.logger.SearchedUsers(ctx, len(users))
+
+	response = &pbuser.SearchUsersResponse{Message: SearchedUsers}
+	for _, user := range users {
+		result := &pbuser.SearchUsersResult{Username: user.Username}
+		if appvisibility.IsVisible(user.ProfileVisibility, appvisibility.FirstName, authenticated) {
+			result.FirstName = &user.FirstName
+		}
+		if appvisibility.IsVisible(user.ProfileVisibility, appvisibility.LastName, authenticated) {
+			result.LastName = &user.LastName
+		}
+		response.Users = append(response.Users, result)
+	}
+	if nextCursor != "" {
+		response.NextPageToken = appmongodbuser.EncodeSearchUsersCursor(nextCursor)
+	}
+	return response, nil
+}
+
+// GetProfile gets the user's profile
+func (s *Server) GetProfile(
+	ctx context.Context,
+	request *pbuser.GetProfileRequest,
+) (response *pbuser.GetProfileResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateGetProfileRequest(request); err != nil {
+		s.logger.FailedToGetUserProfile(ctx, err)
+		return nil, err
+	}
+
+	// Get the profile by username
+	profile, err := s.userDatabase.GetUserProfile(
+		ctx,
+		request.GetUsername(),
+	)
+	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
+		s.logger.FailedToGetUserProfile(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Check if the username doesn't exist
+	if err != nil {
+		// Username does not exist
+		s.logger.UserNotFoundByUsername(ctx, request.GetUsername())
+
+		return nil, status.Error(codes.NotFound, NotFoundByUserId)
+	}
+
+	// Check if the profile owner has blocked the caller, which is answered as if the username doesn't exist
+	if callerId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx); err == nil {
+		blocked, err := s.userDatabase.HasBlocked(ctx, profile.ID.Hex(), callerId)
+		if err != nil {
+			s.logger.FailedToGetUserProfile(ctx, err)
+			return nil, InternalError(ctx, err)
+		}
+		if blocked {
+			s.logger.UserNotFoundByUsername(ctx, request.GetUsername())
+			return nil, status.Error(codes.NotFound, NotFoundByUserId)
+		}
+	}
+
+	// User profile found by username
+	s.logger.GetUserProfile(ctx, request.GetUsername())
+
+	// Set the fields visible to the caller
+	authenticated := isAuthenticated(ctx)
+	response = &pbuser.GetProfileResponse{Message: FetchedUserProfile}
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.FirstName, authenticated) {
+		response.FirstName = &profile.FirstName
+	}
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.LastName, authenticated) {
+		response.LastName = &profile.LastName
+	}
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.Birthdate, authenticated) &&
+		!profile.Birthdate.IsZero() {
+		response.Birthdate = timestamppb.New(profile.Birthdate)
+	}
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.JoinedAt, authenticated) {
+		response.JoinedAt = timestamppb.New(profile.JoinedAt)
+	}
+
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.Bio, authenticated) {
+		response.Bio = profile.Bio
+	}
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.Location, authenticated) {
+		response.Location = profile.Location
+	}
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.Website, authenticated) {
+		response.Website = profile.Website
+	}
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.SocialLinks, authenticated) {
+		response.SocialLinks = profile.SocialLinks
+	}
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.Pronouns, authenticated) {
+		response.Pronouns = profile.Pronouns
+	}
+	if appvisibility.IsVisible(profile.ProfileVisibility, appvisibility.DisplayName, authenticated) {
+		response.DisplayName = profile.DisplayName
+	}
+	return response, nil
+}
+
+// GetProfileVisibility gets the visibility of the user's profile fields
+func (s *Server) GetProfileVisibility(
+	ctx context.Context,
+	request *emptypb.Empty,
+) (response *pbuser.GetProfileVisibilityResponse, err error) {
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the visibility settings
+	settings, err := s.userDatabase.GetProfileVisibility(ctx, userId)
+	if err != nil {
+		s.logger.FailedToGetProfileVisibility(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Visibility settings fetched successfully
+	s.logger.GetProfileVisibility(ctx, userId)
+
+	return &pbuser.GetProfileVisibilityResponse{
+		Message:  FetchedProfileVisibility,
+		Settings: toProfileVisibilitySettings(settings),
+	}, nil
+}
+
+// UpdateProfileVisibility updates the visibility of the given profile fields, keeping the other ones unchanged
+func (s *Server) UpdateProfileVisibility(
+	ctx context.Context,
+	request *pbuser.UpdateProfileVisibilityRequest,
+) (response *pbuser.UpdateProfileVisibilityResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateUpdateProfileVisibilityRequest(request); err != nil {
+		s.logger.FailedToUpdateProfileVisibility(ctx, err)
+		return nil, err
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Update the visibility settings
+	if settings := fromProfileVisibilitySettings(request.GetSettings()); len(settings) > 0 {
+		if err = s.userDatabase.UpdateProfileVisibility(ctx, userId, settings); err != nil {
+			s.logger.FailedToUpdateProfileVisibility(ctx, err)
+			return nil, InternalError(ctx, err)
+		}
+	}
+
+	// Get the updated visibility settings
+	settings, err := s.userDatabase.GetProfileVisibility(ctx, userId)
+	if err != nil {
+		s.logger.FailedToUpdateProfileVisibility(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Visibility settings updated successfully
+	s.logger.UpdatedProfileVisibility(ctx, userId)
+
+	return &pbuser.UpdateProfileVisibilityResponse{
+		Message:  UpdatedProfileVisibility,
+		Settings: toProfileVisibilitySettings(settings),
+	}, nil
+}
+
+// GetPreferences gets the user's preferences
+func (s *Server) GetPreferences(
+	ctx context.Context,
+	request *emptypb.Empty,
+) (response *pbuser.GetPreferencesResponse, err error) {
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the preferences
+	preferences, err := s.userDatabase.GetUserPreferences(ctx, userId)
+	if err != nil {
+		s.logger.FailedToGetPreferences(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Preferences fetched successfully
+	s.logger.GetPreferences(ctx, userId)
+
+	return &pbuser.GetPreferencesResponse{
+		Message:     FetchedPreferences,
+		Preferences: toPreferences(preferences),
+	}, nil
+}
+
+// UpdatePreferences updates the user's preferences included in the update mask
+func (s *Server) UpdatePreferences(
+	ctx context.Context,
+	request *pbuser.UpdatePreferencesRequest,
+) (response *pbuser.UpdatePreferencesResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateUpdatePreferencesRequest(request); err != nil {
+		s.logger.FailedToUpdatePreferences(ctx, err)
+		return nil, err
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Update the preferences
+	preferences, err := s.userDatabase.UpdateUserPreferences(
+		ctx,
+		userId,
+		fromPreferences(request.GetPreferences(), request.GetUpdateMask().GetPaths()),
+	)
+	if err != nil {
+		s.logger.FailedToUpdatePreferences(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Preferences updated successfully
+	s.logger.UpdatedPreferences(ctx, userId)
+
+	return &pbuser.UpdatePreferencesResponse{
+		Message:     UpdatedPreferences,
+		Preferences: toPreferences(preferences),
+	}, nil
+}
+
+// BlockUser blocks a user, so the user can't see the caller's profile
+func (s *Server) BlockUser(
+	ctx context.Context,
+	request *pbuser.BlockUserRequest,
+) (response *pbuser.BlockUserResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateBlockUserRequest(request); err != nil {
+		s.logger.FailedToBlockUser(ctx, err)
+		return nil, err
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the blocked user ID by username
+	blockedId, err := s.userDatabase.GetUserIdByUsername(ctx, request.GetUsername())
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.UserNotFoundByUsername(ctx, request.GetUsername())
+			return nil, status.Error(codes.NotFound, NotFoundByUsername)
+		}
+		s.logger.FailedToBlockUser(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Check if the user is trying to block itself
+	if blockedId == userId {
+		return nil, status.Error(codes.InvalidArgument, CannotBlockSelf)
+	}
+
+	// Block the user
+	if err = s.userDatabase.BlockUser(ctx, userId, blockedId); err != nil {
+		if errors.Is(err, appmongodbuser.UserAlreadyBlockedError) {
+			return nil, status.Error(codes.AlreadyExists, UserAlreadyBlocked)
+		}
+		s.logger.FailedToBlockUser(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// User blocked successfully
+	s.logger.BlockedUser(ctx, userId, blockedId)
+
+	return &pbuser.BlockUserResponse{
+		Message: BlockedUser,
+	}, nil
+}
+
+// UnblockUser unblocks a user
+func (s *Server) UnblockUser(
+	ctx context.Context,
+	request *pbuser.UnblockUserRequest,
+) (response *pbuser.UnblockUserResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateUnblockUserRequest(request); err != nil {
+		s.logger.FailedToUnblockUser(ctx, err)
+		return nil, err
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the blocked user ID by username
+	blockedId, err := s.userDatabase.GetUserIdByUsername(ctx, request.GetUsername())
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.UserNotFoundByUsername(ctx, request.GetUsername())
+			return nil, status.Error(codes.NotFound, NotFoundByUsername)
+		}
+		s.logger.FailedToUnblockUser(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Unblock the user
+	if err = s.userDatabase.UnblockUser(ctx, userId, blockedId); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, UserNotBlocked)
+		}
+		s.logger.FailedToUnblockUser(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// User unblocked successfully
+	s.logger.UnblockedUser(ctx, userId, blockedId)
+
+	return &pbuser.UnblockUserResponse{
+		Message: UnblockedUser,
+	}, nil
+}
+
+// ListBlockedUsers lists the users blocked by the user, from the most recently blocked
+func (s *Server) ListBlockedUsers(
+	ctx context.Context,
+	request *emptypb.Empty,
+) (response *pbuser.ListBlockedUsersResponse, err error) {
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the blocked users
+	blocks, err := s.userDatabase.GetBlockedUsers(ctx, userId)
+	if err != nil {
+		s.logger.FailedToListBlockedUsers(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the usernames of the blocked users, the deleted users are skipped
+	blockedIds := make([]string, len(blocks))
+	for i, block := range blocks {
+		blockedIds[i] = block.BlockedID.Hex()
+	}
+	usernames, _, err := s.userDatabase.GetUsernamesByUserIds(ctx, blockedIds)
+	if err != nil {
+		s.logger.FailedToListBlockedUsers(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	response = &pbuser.ListBlockedUsersResponse{Message: ListedBlockedUsers}
+	for _, block := range blocks {
+		username, ok := usernames[block.BlockedID.Hex()]
+		if !ok {
+			continue
+		}
+		response.Users = append(
+			response.Users, &pbuser.BlockedUser{
+				Username:  username,
+				BlockedAt: timestamppb.New(block.CreatedAt),
+			},
+		)
+	}
+
+	// Blocked users listed successfully
+	s.logger.ListedBlockedUsers(ctx, userId, len(response.Users))
+
+	return response, nil
+}
+
+// IsBlocked checks if either user has blocked the other one, for other services
+func (s *Server) IsBlocked(
+	ctx context.Context,
+	request *pbuser.IsBlockedRequest,
+) (response *pbuser.IsBlockedResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateIsBlockedRequest(request); err != nil {
+		s.logger.FailedToCheckIfBlocked(ctx, err)
+		return nil, err
+	}
+
+	// Check if either user has blocked the other one
+	blocked, err := s.userDatabase.IsBlocked(ctx, request.GetUserId(), request.GetOtherUserId())
+	if err != nil {
+		s.logger.FailedToCheckIfBlocked(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	return &pbuser.IsBlockedResponse{
+		Message: CheckedIfBlocked,
+		Blocked: blocked,
+	}, nil
+}
+
+// EnrollTOTP generates a pending TOTP secret, which is enabled once the user confirms it with a code
+func (s *Server) EnrollTOTP(
+	ctx context.Context,
+	request *emptypb.Empty,
+) (response *pbuser.EnrollTOTPResponse, err error) {
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the username, which is the account name shown by the authenticator apps
+	username, err := s.userDatabase.GetUsernameByUserId(ctx, userId)
+	if err != nil {
+		s.logger.FailedToEnrollTOTP(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Generate the secret
+	secret, uri, err := appmfa.GenerateKey(username)
+	if err != nil {
+		s.logger.FailedToEnrollTOTP(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Store the pending secret
+	if err = s.userDatabase.SetPendingUserTOTP(ctx, userId, secret); err != nil {
+		if errors.Is(err, appmongodbuser.TOTPAlreadyEnabledError) {
+			return nil, status.Error(codes.FailedPrecondition, TOTPIsAlreadyEnabled)
+		}
+		s.logger.FailedToEnrollTOTP(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// TOTP enrolled successfully
+	s.logger.EnrolledTOTP(ctx, userId)
+
+	return &pbuser.EnrollTOTPResponse{
+		Message:    EnrolledTOTP,
+		Secret:     secret,
+		OtpauthUri: uri,
+	}, nil
+}
+
+// ConfirmTOTP enables the pending TOTP with a code from the authenticator app, and returns the recovery codes,
+// which are only shown once
+func (s *Server) ConfirmTOTP(
+	ctx context.Context,
+	request *pbuser.ConfirmTOTPRequest,
+) (response *pbuser.ConfirmTOTPResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateConfirmTOTPRequest(request); err != nil {
+		s.logger.FailedToConfirmTOTP(ctx, err)
+		return nil, err
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the user's pending TOTP
+	userTOTP, err := s.userDatabase.GetUserTOTP(ctx, userId)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.FailedToConfirmTOTP(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, TOTPIsNotEnrolled)
+	}
+	if !userTOTP.ConfirmedAt.IsZero() {
+		return nil, status.Error(codes.FailedPrecondition, TOTPIsAlreadyEnabled)
+	}
+
+	// Check the code
+	step, _, err := s.verifySecondFactor(ctx, userId, userTOTP, request.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate and hash the recovery codes
+	recoveryCodes, err := appmfa.GenerateRecoveryCodes()
+	if err != nil {
+		s.logger.FailedToConfirmTOTP(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	hashedRecoveryCodes, err := hashRecoveryCodes(ctx, recoveryCodes)
+	if err != nil {
+		s.logger.FailedToConfirmTOTP(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Enable the TOTP
+	if err = s.userDatabase.ConfirmUserTOTP(ctx, userId, step, hashedRecoveryCodes); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.FailedPrecondition, TOTPIsNotEnrolled)
+		}
+		s.logger.FailedToConfirmTOTP(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// TOTP confirmed successfully
+	s.logger.ConfirmedTOTP(ctx, userId)
+
+	return &pbuser.ConfirmTOTPResponse{
+		Message:       ConfirmedTOTP,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DisableTOTP disables the TOTP with a TOTP or recovery code, and removes the recovery codes
+func (s *Server) DisableTOTP(
+	ctx context.Context,
+	request *pbuser.DisableTOTPRequest,
+) (response *pbuser.DisableTOTPResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateDisableTOTPRequest(request); err != nil {
+		s.logger.FailedToDisableTOTP(ctx, err)
+		return nil, err
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the user's confirmed TOTP
+	userTOTP, err := s.userDatabase.GetUserTOTP(ctx, userId)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.FailedToDisableTOTP(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err != nil || userTOTP.ConfirmedAt.IsZero() {
+		return nil, status.Error(codes.FailedPrecondition, TOTPIsNotEnabled)
+	}
+
+	// Check the code
+	if _, _, err = s.verifySecondFactor(ctx, userId, userTOTP, request.GetCode()); err != nil {
+		return nil, err
+	}
+
+	// Disable the TOTP
+	if err = s.userDatabase.DeleteUserTOTP(ctx, userId); err != nil {
+		s.logger.FailedToDisableTOTP(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// TOTP disabled successfully
+	s.logger.DisabledTOTP(ctx, userId)
+
+	return &pbuser.DisableTOTPResponse{
+		Message: DisabledTOTP,
+	}, nil
+}
+
+// BeginPasskeyRegistration starts the registration of a passkey, and returns the options for the authenticator
+func (s *Server) BeginPasskeyRegistration(
+	ctx context.Context,
+	request *emptypb.Empty,
+) (response *pbuser.BeginPasskeyRegistrationResponse, err error) {
+	// Check if the passkeys are enabled
+	if err = s.checkPasskeysEnabled(); err != nil {
+		return nil, err
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Get the username and the registered passkeys, which are excluded so they are not registered twice
+	username, err := s.userDatabase.GetUsernameByUserId(ctx, userId)
+	if err != nil {
+		s.logger.FailedToStartPasskeyCeremony(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	user, _, err := s.getPasskeyUser(ctx, userId, username)
+	if err != nil {
+		s.logger.FailedToStartPasskeyCeremony(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	exclusions := make([]protocol.CredentialDescriptor, len(user.Credentials))
+	for i, credential := range user.Credentials {
+		exclusions[i] = credential.Descriptor()
+	}
+
+	// Start the registration
+	options, session, err := s.webAuthn.BeginRegistration(user, webauthn.WithExclusions(exclusions))
+	if err != nil {
+		s.logger.FailedToStartPasskeyCeremony(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Store the session
+	sessionId, encodedOptions, err := s.startPasskeySession(
+		ctx,
+		userId,
+		apppasskey.CeremonyRegistration,
+		session,
+		options,
+	)
+	if err != nil {
+		s.logger.FailedToStartPasskeyCeremony(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Passkey registration started successfully
+	s.logger.StartedPasskeyCeremony(ctx, userId, apppasskey.CeremonyRegistration)
+
+	return &pbuser.BeginPasskeyRegistrationResponse{
+		Message:   StartedPasskeyCeremony,
+		SessionId: sessionId,
+		Options:   encodedOptions,
+	}, nil
+}
+
+// FinishPasskeyRegistration verifies the authenticator response and stores the new passkey
+func (s *Server) FinishPasskeyRegistration(
+	ctx context.Context,
+	request *pbuser.FinishPasskeyRegistrationRequest,
+) (response *pbuser.FinishPasskeyRegistrationResponse, err error) {
+	// Check if the passkeys are enabled
+	if err = s.checkPasskeysEnabled(); err != nil {
+		return nil, err
+	}
+
+	// Validate the request
+	if err = s.validator.ValidateFinishPasskeyRegistrationRequest(request); err != nil {
+		s.logger.FailedToRegisterPasskey(ctx, err)
 		return nil, err
 	}
 
-	// Check if the username exists
-	exists, err := s.userDatabase.UsernameExists(
-		context.Background(),
-		request.GetUsername(),
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Consume the session, which must have been started by the same user
+	sessionUserId, session, err := s.finishPasskeySession(
+		ctx,
+		request.GetSessionId(),
+		apppasskey.CeremonyRegistration,
 	)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.FailedToRegisterPasskey(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err != nil || sessionUserId != userId {
+		return nil, status.Error(codes.NotFound, PasskeySessionNotFound)
+	}
+
+	// Get the username and the registered passkeys
+	username, err := s.userDatabase.GetUsernameByUserId(ctx, userId)
 	if err != nil {
-		s.logger.FailedToCheckIfUsernameExists(err)
-		return nil, InternalServerError
+		s.logger.FailedToRegisterPasskey(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	user, _, err := s.getPasskeyUser(ctx, userId, username)
+	if err != nil {
+		s.logger.FailedToRegisterPasskey(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
-	// Check if the username doesn't exist
-	if !exists {
-		// Username does not exist
-		s.logger.UserNotFoundByUsername(request.GetUsername())
+	// Verify the authenticator response
+	credential, err := apppasskey.FinishRegistration(s.webAuthn, user, *session, request.GetCredential())
+	if err != nil {
+		s.logger.PasskeyIsInvalid(ctx, userId, err)
+		return nil, status.Error(codes.InvalidArgument, PasskeyIsInvalid)
+	}
 
-		return nil, status.Error(codes.NotFound, NotFoundByUsername)
+	// Store the passkey
+	userCredential, err := fromWebAuthnCredential(userId, credential, strings.TrimSpace(request.GetName()))
+	if err != nil {
+		s.logger.FailedToRegisterPasskey(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err = s.userDatabase.InsertUserPasskeyCredential(ctx, userCredential); err != nil {
+		if errors.Is(err, appmongodbuser.PasskeyRegisteredError) {
+			return nil, status.Error(codes.AlreadyExists, PasskeyIsRegistered)
+		}
+		s.logger.FailedToRegisterPasskey(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
-	// User found by username
-	s.logger.UsernameExists(request.GetUsername())
+	// Passkey registered successfully
+	s.logger.RegisteredPasskey(ctx, userId, userCredential.ID.Hex())
 
-	return &pbuser.UsernameExistsResponse{
-		Message: FoundByUsername,
+	return &pbuser.FinishPasskeyRegistrationResponse{
+		Message: RegisteredPasskey,
+		Passkey: toPasskey(userCredential),
 	}, nil
 }
 
-// GetUserIdByUsername gets the user's ID by username
-func (s *Server) GetUserIdByUsername(
+// BeginPasskeyAssertion starts the login of a user with a passkey, for the auth service
+func (s *Server) BeginPasskeyAssertion(
 	ctx context.Context,
-	request *pbuser.GetUserIdByUsernameRequest,
-) (response *pbuser.GetUserIdByUsernameResponse, err error) {
+	request *pbuser.BeginPasskeyAssertionRequest,
+) (response *pbuser.BeginPasskeyAssertionResponse, err error) {
+	// Check if the passkeys are enabled
+	if err = s.checkPasskeysEnabled(); err != nil {
+		return nil, err
+	}
+
 	// Validate the request
-	if err = s.validator.ValidateGetUserIdByUsernameRequest(request); err != nil {
-		s.logger.FailedToGetUserIdByUsername(err)
+	if err = s.validator.ValidateBeginPasskeyAssertionRequest(request); err != nil {
+		s.logger.FailedToStartPasskeyCeremony(ctx, err)
 		return nil, err
 	}
 
 	// Get the user ID by username
-	userId, err := s.userDatabase.GetUserIdByUsername(
-		context.Background(),
-		request.GetUsername(),
-	)
-	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
-		s.logger.FailedToGetUserIdByUsername(err)
-		return nil, InternalServerError
+	username := request.GetUsername()
+	userId, err := s.userDatabase.GetUserIdByUsername(ctx, username)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.FailedToStartPasskeyCeremony(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err != nil {
+		s.logger.UserNotFoundByUsername(ctx, username)
+		return nil, status.Error(codes.NotFound, NotFoundByUsername)
 	}
 
-	// Check if the username doesn't exist
+	// Get the registered passkeys
+	user, _, err := s.getPasskeyUser(ctx, userId, username)
 	if err != nil {
-		// Username does not exist
-		s.logger.UserNotFoundByUsername(request.GetUsername())
+		s.logger.FailedToStartPasskeyCeremony(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if len(user.Credentials) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, NoPasskeysRegistered)
+	}
 
-		return nil, status.Error(codes.NotFound, NotFoundByUsername)
+	// Start the assertion
+	options, session, err := s.webAuthn.BeginLogin(user)
+	if err != nil {
+		s.logger.FailedToStartPasskeyCeremony(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
-	// User found by username
-	s.logger.UserFoundByUsername(request.GetUsername(), userId)
+	// Store the session
+	sessionId, encodedOptions, err := s.startPasskeySession(
+		ctx,
+		userId,
+		apppasskey.CeremonyAssertion,
+		session,
+		options,
+	)
+	if err != nil {
+		s.logger.FailedToStartPasskeyCeremony(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
 
-	return &pbuser.GetUserIdByUsernameResponse{
-		Message: FoundByUsername,
-		UserId:  userId,
+	// Passkey assertion started successfully
+	s.logger.StartedPasskeyCeremony(ctx, userId, apppasskey.CeremonyAssertion)
+
+	return &pbuser.BeginPasskeyAssertionResponse{
+		Message:   StartedPasskeyCeremony,
+		SessionId: sessionId,
+		Options:   encodedOptions,
 	}, nil
 }
 
-// GetUsernameByUserId gets the user's username by ID
-func (s *Server) GetUsernameByUserId(
+// FinishPasskeyAssertion verifies the authenticator response, and returns the ID of the user that logged in
+func (s *Server) FinishPasskeyAssertion(
 	ctx context.Context,
-	request *pbuser.GetUsernameByUserIdRequest,
-) (response *pbuser.GetUsernameByUserIdResponse, err error) {
-	// Validate the request
-	if err = s.validator.ValidateGetUsernameByUserIdRequest(request); err != nil {
-		s.logger.FailedToGetUsernameByUserId(err)
+	request *pbuser.FinishPasskeyAssertionRequest,
+) (response *pbuser.FinishPasskeyAssertionResponse, err error) {
+	// Check if the passkeys are enabled
+	if err = s.checkPasskeysEnabled(); err != nil {
 		return nil, err
 	}
 
-	// Get the username by user ID
-	username, err := s.userDatabase.GetUsernameByUserId(
-		context.Background(),
-		request.GetUserId(),
-	)
-	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
-		s.logger.FailedToGetUsernameByUserId(err)
-		return nil, InternalServerError
+	// Validate the request
+	if err = s.validator.ValidateFinishPasskeyAssertionRequest(request); err != nil {
+		s.logger.FailedToVerifyPasskey(ctx, err)
+		return nil, err
 	}
 
-	// Check if the user ID doesn't exist
+	// Consume the session
+	userId, session, err := s.finishPasskeySession(ctx, request.GetSessionId(), apppasskey.CeremonyAssertion)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.FailedToVerifyPasskey(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
 	if err != nil {
-		// User ID does not exist
-		s.logger.UserNotFoundByUserId(request.GetUserId())
+		return nil, status.Error(codes.NotFound, PasskeySessionNotFound)
+	}
 
+	// Get the user, which must not be deleted nor locked by the support staff
+	user, err := s.userDatabase.FindAdminUserByUserId(ctx, userId)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.FailedToVerifyPasskey(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err != nil || !user.DeletedAt.IsZero() {
+		s.logger.UserNotFoundByUserId(ctx, userId)
 		return nil, status.Error(codes.NotFound, NotFoundByUserId)
 	}
+	if !user.LockedAt.IsZero() {
+		s.logger.UserIsLocked(ctx, userId)
+		return nil, status.Error(codes.PermissionDenied, UserIsLocked)
+	}
 
-	// User found by user ID
-	s.logger.UserFoundByUsername(request.GetUserId(), username)
+	// Check if the user must reset the password before logging in
+	if user.PasswordResetRequired {
+		s.logger.PasswordResetIsRequired(ctx, userId)
+		return nil, status.Error(codes.FailedPrecondition, PasswordResetIsRequired)
+	}
 
-	return &pbuser.GetUsernameByUserIdResponse{
-		Message:  FoundByUserId,
-		Username: username,
+	// Get the registered passkeys
+	passkeyUser, _, err := s.getPasskeyUser(ctx, userId, user.Username)
+	if err != nil {
+		s.logger.FailedToVerifyPasskey(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Verify the authenticator response
+	credential, err := apppasskey.FinishAssertion(s.webAuthn, passkeyUser, *session, request.GetCredential())
+	if errors.Is(err, apppasskey.ClonedAuthenticatorError) {
+		// Flag the passkey, so it keeps being rejected
+		if err = s.userDatabase.FlagUserPasskeyCredentialClone(ctx, credential.ID); err != nil {
+			s.logger.FailedToVerifyPasskey(ctx, err)
+			return nil, InternalError(ctx, err)
+		}
+		s.logger.PasskeyIsCloned(ctx, userId)
+		return nil, status.Error(codes.PermissionDenied, PasskeyIsCloned)
+	}
+	if err != nil {
+		s.logger.PasskeyIsInvalid(ctx, userId, err)
+		return nil, status.Error(codes.InvalidArgument, PasskeyIsInvalid)
+	}
+
+	// Store the sign count, which detects cloned authenticators
+	if err = s.userDatabase.UpdateUserPasskeyCredentialUsage(
+		ctx,
+		credential.ID,
+		credential.Authenticator.SignCount,
+	); err != nil {
+		s.logger.FailedToVerifyPasskey(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Passkey verified successfully
+	s.logger.PasskeyIsValid(ctx, userId)
+
+	return &pbuser.FinishPasskeyAssertionResponse{
+		Message: VerifiedPasskey,
+		UserId:  userId,
 	}, nil
 }
 
-// GetProfile gets the user's profile
-func (s *Server) GetProfile(
+// ListPasskeys lists the passkeys registered by the user
+func (s *Server) ListPasskeys(
 	ctx context.Context,
-	request *pbuser.GetProfileRequest,
-) (response *pbuser.GetProfileResponse, err error) {
-	// Validate the request
-	if err = s.validator.ValidateGetProfileRequest(request); err != nil {
-		s.logger.FailedToGetUserProfile(err)
-		return nil, err
+	request *emptypb.Empty,
+) (response *pbuser.ListPasskeysResponse, err error) {
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
 	}
 
-	// Get the profile by username
-	profile, err := s.userDatabase.GetUserProfile(
-		context.Background(),
-		request.GetUsername(),
-	)
-	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
-		s.logger.FailedToGetUserProfile(err)
-		return nil, InternalServerError
+	// Get the registered passkeys
+	credentials, err := s.userDatabase.GetUserPasskeyCredentials(ctx, userId)
+	if err != nil {
+		s.logger.FailedToListPasskeys(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
-	// Check if the username doesn't exist
+	passkeys := make([]*pbuser.Passkey, len(credentials))
+	for i, credential := range credentials {
+		passkeys[i] = toPasskey(credential)
+	}
+
+	// Passkeys listed successfully
+	s.logger.ListedPasskeys(ctx, userId)
+
+	return &pbuser.ListPasskeysResponse{
+		Message:  ListedPasskeys,
+		Passkeys: passkeys,
+	}, nil
+}
+
+// RevokePasskey removes a passkey of the user, so it can no longer be used to log in
+func (s *Server) RevokePasskey(
+	ctx context.Context,
+	request *pbuser.RevokePasskeyRequest,
+) (response *pbuser.RevokePasskeyResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateRevokePasskeyRequest(request); err != nil {
+		s.logger.FailedToRevokePasskey(ctx, err)
+		return nil, err
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
-		// Username does not exist
-		s.logger.UserNotFoundByUsername(request.GetUsername())
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
 
-		return nil, status.Error(codes.NotFound, NotFoundByUserId)
+	// Revoke the passkey
+	if err = s.userDatabase.DeleteUserPasskeyCredential(ctx, userId, request.GetId()); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, NotFoundPasskey)
+		}
+		s.logger.FailedToRevokePasskey(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
-	// User profile found by username
-	s.logger.GetUserProfile(request.GetUsername())
+	// Passkey revoked successfully
+	s.logger.RevokedPasskey(ctx, userId, request.GetId())
 
-	return &pbuser.GetProfileResponse{
-		Message:   FetchedUserProfile,
-		FirstName: profile.FirstName,
-		LastName:  profile.LastName,
-		JoinedAt:  timestamppb.New(profile.JoinedAt),
+	return &pbuser.RevokePasskeyResponse{
+		Message: RevokedPasskey,
 	}, nil
 }
 
@@ -330,48 +1441,53 @@ func (s *Server) UpdateUser(
 	ctx context.Context,
 	request *pbuser.UpdateUserRequest,
 ) (response *pbuser.UpdateUserResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateUpdateUserRequest(request); err != nil {
+		s.logger.FailedToUpdateUser(ctx, err)
+		return nil, err
+	}
+
 	// Get the user ID from the access token
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
-	// Create the update fields BSON
-	var update bson.M
+	// Get the profile fields to set and to clear
+	set, unset := updateProfileFields(request)
 
-	// Iterate over the request string fields
-	for key, value := range map[string]interface{}{
-		"first_name": request.GetFirstName(),
-		"last_name":  request.GetLastName(),
-	} {
-		// Skip empty values
-		if value != "" {
-			update[key] = value
-		}
+	// Update the user, checking the expected version if it was given
+	version, err := s.userDatabase.UpdateUserProfile(
+		ctx,
+		userId,
+		set,
+		unset,
+		request.ExpectedVersion,
+	)
+	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) && !errors.Is(err, appmongodbuser.VersionMismatchError) {
+		s.logger.FailedToUpdateUser(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
-	// Iterate over the request time fields
-	if request.GetBirthdate() != nil {
-		update["birthdate"] = request.GetBirthdate().AsTime()
+	// Check if the user doesn't exist
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.UserNotFoundByUserId(ctx, userId)
+		return nil, status.Error(codes.NotFound, NotFoundByUserId)
 	}
 
-	// Update the user
-	_, err = s.userDatabase.UpdateUserByUserId(
-		context.Background(),
-		userId,
-		&update,
-	)
-	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
-		s.logger.FailedToUpdateUser(err)
-		return nil, InternalServerError
+	// Check if the user was updated by another request since the expected version was read
+	if err != nil {
+		s.logger.UserVersionMismatch(ctx, userId, request.GetExpectedVersion())
+		return nil, status.Error(codes.Aborted, UserVersionMismatch)
 	}
 
 	// User found by user ID
-	s.logger.UpdatedUser(userId)
+	s.logger.UpdatedUser(ctx, userId)
 
 	return &pbuser.UpdateUserResponse{
 		Message: Updated,
+		Version: version,
 	}, nil
 }
 
@@ -382,7 +1498,7 @@ func (s *Server) ChangeUsername(
 ) (response *pbuser.ChangeUsernameResponse, err error) {
 	// Validate the request
 	if err = s.validator.ValidateChangeUsernameRequest(request); err != nil {
-		s.logger.FailedToGetUserProfile(err)
+		s.logger.FailedToGetUserProfile(ctx, err)
 		return nil, err
 	}
 
@@ -390,26 +1506,26 @@ func (s *Server) ChangeUsername(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Update the user's username
-	err = s.userDatabase.UpdateUserUsername(userId, request.GetUsername())
+	err = s.userDatabase.UpdateUserUsername(ctx, userId, request.GetUsername())
 	if err != nil && !mongo.IsDuplicateKeyError(err) {
-		s.logger.FailedToUpdateUsername(err)
-		return nil, InternalServerError
+		s.logger.FailedToUpdateUsername(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Check if the username already exists
 	if err != nil {
 		// Username exists
-		s.logger.UsernameExists(request.GetUsername())
+		s.logger.UsernameExists(ctx, request.GetUsername())
 
 		return nil, status.Error(codes.AlreadyExists, UsernameExists)
 	}
 
 	// Updated the user's username
-	s.logger.UpdatedUsername(userId, request.GetUsername())
+	s.logger.UpdatedUsername(ctx, userId, request.GetUsername())
 
 	return &pbuser.ChangeUsernameResponse{
 		Message: UpdatedUsername,
@@ -423,7 +1539,7 @@ func (s *Server) ChangePassword(
 ) (response *pbuser.ChangePasswordResponse, err error) {
 	// Validate the request
 	if err = s.validator.ValidateChangePasswordRequest(request); err != nil {
-		s.logger.FailedToUpdatePassword(err)
+		s.logger.FailedToUpdatePassword(ctx, err)
 		return nil, err
 	}
 
@@ -431,51 +1547,52 @@ func (s *Server) ChangePassword(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Check if the old password is correct
 	userHashedPassword, err := s.userDatabase.GetUserHashedPassword(
-		context.Background(),
+		ctx,
 		userId,
 	)
 	if err != nil {
-		s.logger.FailedToComparePassword(err)
-		return nil, InternalServerError
+		s.logger.FailedToComparePassword(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Check if the password matches
-	matches := commonbcrypt.CheckPasswordHash(
+	matches := checkPasswordHash(
+		ctx,
 		userHashedPassword.HashedPassword,
 		request.GetOldPassword(),
 	)
 	if !matches {
-		s.logger.PasswordIsIncorrect(userId)
+		s.logger.PasswordIsIncorrect(ctx, userId)
 		return nil, status.Error(codes.InvalidArgument, FailedToComparePassword)
 	}
 
 	// Get the user's hashed password
-	hashedNewPassword, err := commonbcrypt.HashPassword(request.GetNewPassword())
+	hashedNewPassword, err := hashPassword(ctx, request.GetNewPassword())
 	if err != nil {
-		s.logger.FailedToHashPassword(err)
-		return nil, InternalServerError
+		s.logger.FailedToHashPassword(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Get outgoing gRPC context
-	grpcCtx, err := commongrpcclientctx.GetOutgoingCtx(ctx)
+	grpcCtx, err := appgrpcclientctx.GetOutgoingCtx(ctx)
 	if err != nil {
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Update the user's password
 	err = s.userDatabase.UpdateUserPassword(grpcCtx, userId, hashedNewPassword)
 	if err != nil {
-		s.logger.FailedToUpdatePassword(err)
-		return nil, InternalServerError
+		s.logger.FailedToUpdatePassword(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Updated the user's password
-	s.logger.UpdatedPassword(userId)
+	s.logger.UpdatedPassword(ctx, userId)
 
 	return &pbuser.ChangePasswordResponse{
 		Message: UpdatedPassword,
@@ -491,21 +1608,21 @@ func (s *Server) GetPhoneNumber(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Get the current phone number by user ID
 	phoneNumber, err := s.userDatabase.GetUserPhoneNumber(
-		context.Background(),
+		ctx,
 		userId,
 	)
 	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
-		s.logger.FailedToGetUserPhoneNumber(err)
-		return nil, InternalServerError
+		s.logger.FailedToGetUserPhoneNumber(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// User found by user ID
-	s.logger.GetUserPhoneNumber(userId, phoneNumber)
+	s.logger.GetUserPhoneNumber(ctx, userId, phoneNumber)
 
 	return &pbuser.GetPhoneNumberResponse{
 		Message:     FetchedPhoneNumber,
@@ -520,7 +1637,7 @@ func (s *Server) ChangePhoneNumber(
 ) (response *pbuser.ChangePhoneNumberResponse, err error) {
 	// Validate the request
 	if err = s.validator.ValidateChangePhoneNumberRequest(request); err != nil {
-		s.logger.FailedToUpdatePhoneNumber(err)
+		s.logger.FailedToUpdatePhoneNumber(ctx, err)
 		return nil, err
 	}
 
@@ -528,18 +1645,18 @@ func (s *Server) ChangePhoneNumber(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Update the user's phone number
-	err = s.userDatabase.UpdateUserPhoneNumber(userId, request.GetPhoneNumber())
+	err = s.userDatabase.UpdateUserPhoneNumber(ctx, userId, request.GetPhoneNumber())
 	if err != nil {
-		s.logger.FailedToUpdatePhoneNumber(err)
-		return nil, InternalServerError
+		s.logger.FailedToUpdatePhoneNumber(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Updated the user's phone number
-	s.logger.UpdatedUserPhoneNumber(userId, request.GetPhoneNumber())
+	s.logger.UpdatedUserPhoneNumber(ctx, userId, request.GetPhoneNumber())
 
 	return &pbuser.ChangePhoneNumberResponse{
 		Message: UpdatedPhoneNumber,
@@ -553,7 +1670,7 @@ func (s *Server) AddEmail(
 ) (response *pbuser.AddEmailResponse, err error) {
 	// Validate the request
 	if err = s.validator.ValidateAddEmailRequest(request); err != nil {
-		s.logger.FailedToAddUserEmail(err)
+		s.logger.FailedToAddUserEmail(ctx, err)
 		return nil, err
 	}
 
@@ -561,25 +1678,25 @@ func (s *Server) AddEmail(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Add the email to the user's account
-	err = s.userDatabase.UpdateUserPhoneNumber(userId, request.GetEmail())
+	err = s.userDatabase.AddUserEmail(ctx, userId, request.GetEmail())
 	if err != nil && !errors.Is(appmongodbuser.EmailAlreadyExistsError, err) {
-		s.logger.FailedToAddUserEmail(err)
-		return nil, InternalServerError
+		s.logger.FailedToAddUserEmail(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Check if the email already exists
 	if err != nil {
-		s.logger.UserEmailAlreadyExists(userId, request.GetEmail())
+		s.logger.UserEmailAlreadyExists(ctx, userId, request.GetEmail())
 
 		return nil, status.Error(codes.AlreadyExists, FailedToAddUserEmail)
 	}
 
 	// Added email to the user's account
-	s.logger.AddedUserEmail(userId, request.GetEmail())
+	s.logger.AddedUserEmail(ctx, userId, request.GetEmail())
 
 	return &pbuser.AddEmailResponse{
 		Message: AddedUserEmail,
@@ -593,7 +1710,7 @@ func (s *Server) DeleteEmail(
 ) (response *pbuser.DeleteEmailResponse, err error) {
 	// Validate the request
 	if err = s.validator.ValidateDeleteEmailRequest(request); err != nil {
-		s.logger.FailedToDeleteUserEmail(err)
+		s.logger.FailedToDeleteUserEmail(ctx, err)
 		return nil, err
 	}
 
@@ -601,29 +1718,29 @@ func (s *Server) DeleteEmail(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Delete the email from the user's account
 	err = s.userDatabase.DeleteUserEmail(
-		context.Background(),
+		ctx,
 		userId,
 		request.GetEmail(),
 	)
 	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
-		s.logger.FailedToDeleteUserEmail(err)
-		return nil, InternalServerError
+		s.logger.FailedToDeleteUserEmail(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Check if the email doesn't exist, or it's the primary email
 	if err != nil {
-		s.logger.FailedToDeleteUserEmail(err)
+		s.logger.FailedToDeleteUserEmail(ctx, err)
 
 		return nil, status.Error(codes.NotFound, FailedToDeleteUserEmail)
 	}
 
 	// Deleted email from the user's account
-	s.logger.DeletedUserEmail(userId, request.GetEmail())
+	s.logger.DeletedUserEmail(ctx, userId, request.GetEmail())
 
 	return &pbuser.DeleteEmailResponse{
 		Message: DeletedUserEmail,
@@ -639,21 +1756,21 @@ func (s *Server) GetPrimaryEmail(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Get the current primary email by user ID
 	primaryEmail, err := s.userDatabase.GetUserPrimaryEmail(
-		context.Background(),
+		ctx,
 		userId,
 	)
 	if err != nil {
-		s.logger.FailedToGetPrimaryEmail(err)
-		return nil, InternalServerError
+		s.logger.FailedToGetPrimaryEmail(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// User primary email found by user ID
-	s.logger.GetUserPrimaryEmail(userId, primaryEmail)
+	s.logger.GetUserPrimaryEmail(ctx, userId, primaryEmail)
 
 	return &pbuser.GetPrimaryEmailResponse{
 		Message: FetchedUserPrimaryEmail,
@@ -668,7 +1785,7 @@ func (s *Server) ChangePrimaryEmail(
 ) (response *pbuser.ChangePrimaryEmailResponse, err error) {
 	// Validate the request
 	if err = s.validator.ValidateChangePrimaryEmailRequest(request); err != nil {
-		s.logger.FailedToUpdateUserPrimaryEmail(err)
+		s.logger.FailedToUpdateUserPrimaryEmail(ctx, err)
 		return nil, err
 	}
 
@@ -676,25 +1793,32 @@ func (s *Server) ChangePrimaryEmail(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Update the user's primary email
-	err = s.userDatabase.UpdateUserPrimaryEmail(userId, request.GetEmail())
-	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) {
-		s.logger.FailedToUpdateUserPrimaryEmail(err)
-		return nil, InternalServerError
+	err = s.userDatabase.UpdateUserPrimaryEmail(ctx, userId, request.GetEmail())
+	if err != nil && !errors.Is(mongo.ErrNoDocuments, err) && !errors.Is(err, appmongodbuser.EmailNotVerifiedError) {
+		s.logger.FailedToUpdateUserPrimaryEmail(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Check if the user email doesn't exist
-	if err != nil {
-		s.logger.UserEmailNotFound(userId, request.GetEmail())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.UserEmailNotFound(ctx, userId, request.GetEmail())
 
 		return nil, status.Error(codes.NotFound, NotFoundUserEmail)
 	}
 
+	// Check if the user email is still pending verification
+	if err != nil {
+		s.logger.UserEmailNotVerified(ctx, userId, request.GetEmail())
+
+		return nil, status.Error(codes.FailedPrecondition, UserEmailNotVerified)
+	}
+
 	// Change user primary email
-	s.logger.UpdatedUserPrimaryEmail(userId, request.GetEmail())
+	s.logger.UpdatedUserPrimaryEmail(ctx, userId, request.GetEmail())
 
 	return &pbuser.ChangePrimaryEmailResponse{
 		Message: UpdatedUserPrimaryEmail,
@@ -710,26 +1834,35 @@ func (s *Server) GetActiveEmails(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Get the active emails by user ID
 	activeEmails, err := s.userDatabase.GetUserActiveEmails(
-		context.Background(),
+		ctx,
 		userId,
 	)
 	if err != nil {
-		s.logger.FailedToGetActiveEmails(err)
-		return nil, InternalServerError
+		s.logger.FailedToGetActiveEmails(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// User active emails found by user ID
-	s.logger.GetUserActiveEmails(userId)
-
-	return &pbuser.GetActiveEmailsResponse{
-		Message: FetchedUserActiveEmails,
-		Emails:  activeEmails,
-	}, nil
+	s.logger.GetUserActiveEmails(ctx, userId)
+
+	response := &pbuser.GetActiveEmailsResponse{Message: FetchedUserActiveEmails}
+	for _, email := range activeEmails {
+		response.Emails = append(response.Emails, email.Email)
+		response.EmailDetails = append(
+			response.EmailDetails, &pbuser.Email{
+				Email:    email.Email,
+				Verified: !email.VerifiedAt.IsZero(),
+				Primary:  email.IsPrimary,
+				AddedAt:  timestamppb.New(email.AssignedAt),
+			},
+		)
+	}
+	return response, nil
 }
 
 // DeleteUser deletes the user's account
@@ -739,7 +1872,7 @@ func (s *Server) DeleteUser(
 ) (response *pbuser.DeleteUserResponse, err error) {
 	// Validate the request
 	if err = s.validator.ValidateDeleteUserRequest(request); err != nil {
-		s.logger.FailedToDeleteUser(err)
+		s.logger.FailedToDeleteUser(ctx, err)
 		return nil, err
 	}
 
@@ -747,44 +1880,45 @@ func (s *Server) DeleteUser(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Check if the password is correct
 	userHashedPassword, err := s.userDatabase.GetUserHashedPassword(
-		context.Background(),
+		ctx,
 		userId,
 	)
 	if err != nil {
-		s.logger.FailedToComparePassword(err)
-		return nil, InternalServerError
+		s.logger.FailedToComparePassword(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// Check if the password matches
-	matches := commonbcrypt.CheckPasswordHash(
+	matches := checkPasswordHash(
+		ctx,
 		userHashedPassword.HashedPassword,
 		request.GetPassword(),
 	)
 	if !matches {
-		s.logger.PasswordIsIncorrect(userId)
+		s.logger.PasswordIsIncorrect(ctx, userId)
 		return nil, status.Error(codes.InvalidArgument, FailedToComparePassword)
 	}
 
 	// Get outgoing gRPC context
-	grpcCtx, err := commongrpcclientctx.GetOutgoingCtx(ctx)
+	grpcCtx, err := appgrpcclientctx.GetOutgoingCtx(ctx)
 	if err != nil {
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Delete user
 	err = s.userDatabase.DeleteUser(grpcCtx, userId)
 	if err != nil {
-		s.logger.FailedToDeleteUser(err)
-		return nil, InternalServerError
+		s.logger.FailedToDeleteUser(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// User deleted successfully
-	s.logger.DeletedUser(userId)
+	s.logger.DeletedUser(ctx, userId)
 
 	return &pbuser.DeleteUserResponse{
 		Message: DeletedUser,
@@ -800,53 +1934,146 @@ func (s *Server) GetMyProfile(
 	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
 	if err != nil {
 		s.jwtValidatorLogger.MissingTokenClaimsUserId()
-		return nil, InternalServerError
+		return nil, InternalError(ctx, err)
 	}
 
 	// Get the user own profile by user ID
-	fullProfile, emails, phoneNumber, err := s.userDatabase.GetMyProfile(userId)
+	fullProfile, emails, phoneNumber, err := s.userDatabase.GetMyProfile(ctx, userId)
 	if err != nil {
-		s.logger.FailedToGetUserOwnProfile(err)
-		return nil, InternalServerError
+		s.logger.FailedToGetUserOwnProfile(ctx, err)
+		return nil, InternalError(ctx, err)
 	}
 
 	// User own profile found by user ID
-	s.logger.GetUserOwnProfile(userId)
+	s.logger.GetUserOwnProfile(ctx, userId)
 
-	return &pbuser.GetMyProfileResponse{
+	response = &pbuser.GetMyProfileResponse{
 		Message:     FetchedUserOwnProfile,
 		Username:    fullProfile.Username,
 		FirstName:   fullProfile.FirstName,
 		LastName:    fullProfile.LastName,
-		Birthdate:   timestamppb.New(fullProfile.Birthdate),
 		JoinedAt:    timestamppb.New(fullProfile.JoinedAt),
 		Emails:      *emails,
 		PhoneNumber: phoneNumber,
-	}, nil
-}
-
-// --- Requires more development ---
+		Bio:         fullProfile.Bio,
+		Location:    fullProfile.Location,
+		Website:     fullProfile.Website,
+		SocialLinks: fullProfile.SocialLinks,
+		Pronouns:    fullProfile.Pronouns,
+		DisplayName: fullProfile.DisplayName,
+		Version:     fullProfile.Version,
+	}
 
-func (s *Server) SetProfilePicture(
-	ctx context.Context,
-	request *pbuser.SetProfilePictureRequest,
-) (*pbuser.SetProfilePictureResponse, error) {
-	return nil, InDevelopmentError
+	// Add the birthdate if it's set, since it can be cleared
+	if !fullProfile.Birthdate.IsZero() {
+		response.Birthdate = timestamppb.New(fullProfile.Birthdate)
+	}
+	return response, nil
 }
 
-// SendVerificationEmail sends a verification email to the user
+// SendVerificationEmail sends an email verification link to a pending email of the user
 func (s *Server) SendVerificationEmail(
 	ctx context.Context,
 	request *pbuser.SendVerificationEmailRequest,
-) (*pbuser.SendVerificationEmailResponse, error) {
-	return nil, InDevelopmentError
+) (response *pbuser.SendVerificationEmailResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateSendVerificationEmailRequest(request); err != nil {
+		s.logger.FailedToSendVerificationEmail(ctx, err)
+		return nil, err
+	}
+
+	// Check if the emails are enabled
+	if s.mailer == nil {
+		s.logger.EmailsAreDisabled(ctx)
+		return nil, status.Error(codes.FailedPrecondition, EmailsAreDisabled)
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Create the email verification, only the hash of its token is stored
+	email := request.GetEmail()
+	token, err := appmail.NewToken()
+	if err != nil {
+		s.logger.FailedToSendVerificationEmail(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err = s.userDatabase.CreateUserEmailVerification(
+		ctx,
+		userId,
+		email,
+		appmail.HashToken(token),
+		s.verifyEmailTTL,
+	); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.PendingEmailNotFound(ctx, userId, email)
+			return nil, status.Error(codes.NotFound, PendingEmailNotFound)
+		}
+		s.logger.FailedToSendVerificationEmail(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Send the email verification link
+	if err = s.mailer.SendEmailVerification(ctx, email, token, s.verifyEmailTTL); err != nil {
+		s.logger.FailedToSendVerificationEmail(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Email verification link sent successfully
+	s.logger.SentVerificationEmail(ctx, userId, email)
+
+	return &pbuser.SendVerificationEmailResponse{
+		Message: SentVerificationEmail,
+	}, nil
 }
 
-// VerifyEmail verifies the user's email
+// VerifyEmail verifies the user's email with the token of an email verification link
 func (s *Server) VerifyEmail(
 	ctx context.Context,
 	request *pbuser.VerifyEmailRequest,
-) (*pbuser.VerifyEmailResponse, error) {
+) (response *pbuser.VerifyEmailResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateVerifyEmailRequest(request); err != nil {
+		s.logger.FailedToVerifyEmail(ctx, err)
+		return nil, err
+	}
+
+	// Get the user ID from the access token
+	userId, err := commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	if err != nil {
+		s.jwtValidatorLogger.MissingTokenClaimsUserId()
+		return nil, InternalError(ctx, err)
+	}
+
+	// Verify the email with the verification of the token
+	email, err := s.userDatabase.VerifyUserEmailByToken(ctx, userId, appmail.HashToken(request.GetToken()))
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.VerifyEmailTokenIsInvalid(ctx, userId)
+			return nil, status.Error(codes.InvalidArgument, VerifyEmailTokenIsInvalid)
+		}
+		s.logger.FailedToVerifyEmail(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Email verified successfully
+	s.logger.VerifiedEmail(ctx, userId, email)
+
+	return &pbuser.VerifyEmailResponse{
+		Message: VerifiedEmail,
+	}, nil
+}
+
+// --- Requires more development ---
+
+func (s *Server) SetProfilePicture(
+	ctx context.Context,
+	request *pbuser.SetProfilePictureRequest,
+) (*pbuser.SetProfilePictureResponse, error) {
 	return nil, InDevelopmentError
 }
 
@@ -866,18 +2093,109 @@ func (s *Server) SendVerificationSMS(
 	return nil, InDevelopmentError
 }
 
-// ForgotPassword sends a password reset link to the user's email
+// ForgotPassword sends a password reset link to the user's verified primary email. The response is the same whether
+// the link was sent or not, so it can't be used to find out which usernames exist
 func (s *Server) ForgotPassword(
 	ctx context.Context,
 	request *pbuser.ForgotPasswordRequest,
-) (*pbuser.ForgotPasswordResponse, error) {
-	return nil, InDevelopmentError
+) (response *pbuser.ForgotPasswordResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateForgotPasswordRequest(request); err != nil {
+		s.logger.FailedToSendResetPassword(ctx, err)
+		return nil, err
+	}
+
+	// Check if the emails are enabled
+	if s.mailer == nil {
+		s.logger.EmailsAreDisabled(ctx)
+		return nil, status.Error(codes.FailedPrecondition, EmailsAreDisabled)
+	}
+
+	// Get the user ID and the verified primary email
+	username := request.GetUsername()
+	userId, err := s.userDatabase.GetUserIdByUsername(ctx, username)
+	var email string
+	if err == nil {
+		email, err = s.userDatabase.GetUserVerifiedPrimaryEmail(ctx, userId)
+	}
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.ResetPasswordNotSent(ctx, username)
+			return &pbuser.ForgotPasswordResponse{
+				Message: SentResetPassword,
+			}, nil
+		}
+		s.logger.FailedToSendResetPassword(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Create the password reset, only the hash of its token is stored
+	token, err := appmail.NewToken()
+	if err != nil {
+		s.logger.FailedToSendResetPassword(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+	if err = s.userDatabase.CreateUserResetPassword(
+		ctx,
+		userId,
+		appmail.HashToken(token),
+		s.resetPasswordTTL,
+	); err != nil {
+		s.logger.FailedToSendResetPassword(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Send the password reset link
+	if err = s.mailer.SendResetPassword(ctx, email, token, s.resetPasswordTTL); err != nil {
+		s.logger.FailedToSendResetPassword(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Password reset link sent successfully
+	s.logger.SentResetPassword(ctx, userId)
+
+	return &pbuser.ForgotPasswordResponse{
+		Message: SentResetPassword,
+	}, nil
 }
 
-// ResetPassword resets the user's password
+// ResetPassword sets the user's password with the token of a password reset link
 func (s *Server) ResetPassword(
 	ctx context.Context,
 	request *pbuser.ResetPasswordRequest,
-) (*pbuser.ResetPasswordResponse, error) {
-	return nil, InDevelopmentError
+) (response *pbuser.ResetPasswordResponse, err error) {
+	// Validate the request
+	if err = s.validator.ValidateResetPasswordRequest(request); err != nil {
+		s.logger.FailedToResetPassword(ctx, err)
+		return nil, err
+	}
+
+	// Hash the new password
+	hashedPassword, err := hashPassword(ctx, request.GetNewPassword())
+	if err != nil {
+		s.logger.FailedToHashPassword(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Set the password with the password reset of the token
+	userId, err := s.userDatabase.ResetUserPassword(
+		ctx,
+		appmail.HashToken(request.GetToken()),
+		hashedPassword,
+	)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.ResetPasswordTokenIsInvalid(ctx)
+			return nil, status.Error(codes.InvalidArgument, ResetPasswordTokenIsInvalid)
+		}
+		s.logger.FailedToResetPassword(ctx, err)
+		return nil, InternalError(ctx, err)
+	}
+
+	// Password reset successfully
+	s.logger.PasswordReset(ctx, userId)
+
+	return &pbuser.ResetPasswordResponse{
+		Message: PasswordReset,
+	}, nil
 }
@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"errors"
+	appmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb/user"
+	appmfa "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/mfa"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// hashRecoveryCodes hashes the normalized recovery codes, so they can be stored
+func hashRecoveryCodes(ctx context.Context, recoveryCodes []string) ([]string, error) {
+	hashedRecoveryCodes := make([]string, len(recoveryCodes))
+	for i, recoveryCode := range recoveryCodes {
+		hashedRecoveryCode, err := hashPassword(ctx, appmfa.NormalizeRecoveryCode(recoveryCode))
+		if err != nil {
+			return nil, err
+		}
+		hashedRecoveryCodes[i] = hashedRecoveryCode
+	}
+	return hashedRecoveryCodes, nil
+}
+
+// consumeRecoveryCode finds the hashed recovery code that matches the code and removes it, and returns false if
+// no unused recovery code matches
+func (s *Server) consumeRecoveryCode(
+	ctx context.Context,
+	userId string,
+	userTOTP *appmongodbuser.UserTOTP,
+	code string,
+) (bool, error) {
+	normalizedCode := appmfa.NormalizeRecoveryCode(code)
+	for _, hashedRecoveryCode := range userTOTP.RecoveryCodes {
+		if !checkPasswordHash(ctx, normalizedCode, hashedRecoveryCode) {
+			continue
+		}
+
+		// Remove the recovery code, it may have been used by a concurrent request
+		err := s.userDatabase.ConsumeUserRecoveryCode(ctx, userId, hashedRecoveryCode)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	return false, nil
+}
+
+// verifySecondFactor checks the TOTP or recovery code of the user, counting the attempts and rejecting the codes
+// while the second factor is locked. It returns the period of the matched TOTP code, which is zero if a recovery code
+// was used
+func (s *Server) verifySecondFactor(
+	ctx context.Context,
+	userId string,
+	userTOTP *appmongodbuser.UserTOTP,
+	code string,
+) (step int64, usedRecoveryCode bool, err error) {
+	// Count the attempt, unless the second factor is locked after too many failed attempts
+	if err = s.userDatabase.CountUserTOTPAttempt(
+		ctx,
+		userId,
+		appmfa.MaxAttempts,
+		appmfa.LockoutDuration,
+	); err != nil {
+		if errors.Is(err, appmongodbuser.TOTPLockedError) {
+			s.logger.SecondFactorIsLocked(ctx, userId)
+			return 0, false, status.Error(codes.ResourceExhausted, SecondFactorIsLocked)
+		}
+		return 0, false, InternalError(ctx, err)
+	}
+
+	// Check the code as a TOTP code or as a recovery code
+	var matches bool
+	if appmfa.IsTOTPCode(code) {
+		step, matches = appmfa.MatchTOTPCode(userTOTP.Secret, code, time.Now(), userTOTP.LastUsedStep)
+	} else {
+		matches, err = s.consumeRecoveryCode(ctx, userId, userTOTP, code)
+		if err != nil {
+			return 0, false, InternalError(ctx, err)
+		}
+		usedRecoveryCode = matches
+	}
+	if !matches {
+		s.logger.SecondFactorIsIncorrect(ctx, userId)
+		return 0, false, status.Error(codes.InvalidArgument, SecondFactorIsIncorrect)
+	}
+
+	// Reset the counted attempts, the TOTP code is rejected if a concurrent request already used it
+	if err = s.userDatabase.RecordUserTOTPSuccess(ctx, userId, step); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			s.logger.SecondFactorIsIncorrect(ctx, userId)
+			return 0, false, status.Error(codes.InvalidArgument, SecondFactorIsIncorrect)
+		}
+		return 0, false, InternalError(ctx, err)
+	}
+	return step, usedRecoveryCode, nil
+}
@@ -0,0 +1,39 @@
+package validator
+
+const (
+	// BatchLimitKey is the key of the maximum number of items resolved by a batch lookup
+	BatchLimitKey = "USER_SERVICE_BATCH_LIMIT"
+
+	// BatchLimit is the default maximum number of items resolved by a batch lookup
+	BatchLimit = 100
+
+	// MinSearchQueryLength is the minimum length of the users search query
+	MinSearchQueryLength = 2
+
+	// MaxSearchQueryLength is the maximum length of the users search query
+	MaxSearchQueryLength = 64
+
+	// DefaultSearchPageSize is the number of users of a search page when the page size is not set
+	DefaultSearchPageSize = 20
+
+	// MaxSearchPageSize is the maximum number of users of a search page
+	MaxSearchPageSize = 100
+
+	// MaxBioLength is the maximum length of the user's bio
+	MaxBioLength = 300
+
+	// MaxLocationLength is the maximum length of the user's location
+	MaxLocationLength = 100
+
+	// MaxUrlLength is the maximum length of the user's website and social links
+	MaxUrlLength = 200
+
+	// MaxSocialLinks is the maximum number of social links of the user
+	MaxSocialLinks = 5
+
+	// MaxPronounsLength is the maximum length of the user's pronouns
+	MaxPronounsLength = 32
+
+	// MaxDisplayNameLength is the maximum length of the user's display name
+	MaxDisplayNameLength = 50
+)
@@ -3,6 +3,27 @@ package validator
 import "errors"
 
 var (
-	UsernameTakenError        = errors.New("username taken")
-	NewPasswordSameAsOldError = errors.New("new password same as old")
+	UsernameTakenError         = errors.New("username taken")
+	NewPasswordSameAsOldError  = errors.New("new password same as old")
+	SearchQueryLengthError     = errors.New("search query must be between 2 and 64 characters")
+	PageSizeOutOfRangeError    = errors.New("page size must be between 1 and 100")
+	BatchLimitExceededError    = errors.New("too many items, the maximum is")
+	NonPositiveBatchLimitError = errors.New("batch limit must be positive")
+	MissingSettingsError       = errors.New("settings are required")
+	InvalidVisibilityError     = errors.New("visibility must be public, authenticated or private")
+	FieldTooLongError          = errors.New("field is too long, the maximum number of characters is")
+	InvalidCharactersError     = errors.New("field contains characters that are not allowed")
+	InvalidUrlError            = errors.New("url must be an absolute http or https url")
+	TooManySocialLinksError    = errors.New("too many social links, the maximum is")
+	DuplicateSocialLinkError   = errors.New("social link is duplicated")
+	MissingPreferencesError    = errors.New("preferences are required")
+	MissingUpdateMaskError     = errors.New("update mask must have at least one path")
+	UnknownUpdateMaskPathError = errors.New("update mask path is not allowed:")
+	ClearedRequiredPathError   = errors.New("update mask path cannot be cleared:")
+	InvalidLocaleError         = errors.New("locale is not supported")
+	InvalidTimezoneError       = errors.New("timezone must be an IANA time zone name")
+	InvalidCurrencyError       = errors.New("currency is not supported")
+	InvalidUserIdError         = errors.New("user id is not valid")
+	InvalidTOTPCodeError       = errors.New("code must have 6 digits")
+	InvalidVersionError        = errors.New("version cannot be negative")
 )
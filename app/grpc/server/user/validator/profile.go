@@ -0,0 +1,111 @@
+package validator
+
+import (
+	"fmt"
+	commonvalidatorfields "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/validator/fields"
+	"net/url"
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// displayNameRegex matches the letters, digits, spaces, dots, apostrophes, hyphens and underscores
+	displayNameRegex = regexp.MustCompile(`^[\p{L}\p{M}\p{N} .'_-]+$`)
+
+	// locationRegex matches the letters, digits, spaces, commas, dots, apostrophes, hyphens and parentheses
+	locationRegex = regexp.MustCompile(`^[\p{L}\p{M}\p{N} ,.'()-]+$`)
+
+	// pronounsRegex matches the letters, spaces, slashes and hyphens
+	pronounsRegex = regexp.MustCompile(`^[\p{L}\p{M} /-]+$`)
+)
+
+// validateLength checks if the field is not longer than the maximum number of characters
+func validateLength(
+	field string,
+	value string,
+	maxLength int,
+	validations *commonvalidatorfields.StructFieldsValidations,
+) bool {
+	if utf8.RuneCountInString(value) > maxLength {
+		validations.AddFailedFieldValidationError(field, fmt.Errorf("%w %d", FieldTooLongError, maxLength))
+		return false
+	}
+	return true
+}
+
+// validateText checks if the field is not longer than the maximum number of characters and only contains the
+// characters matched by the regex. An empty value clears the field
+func validateText(
+	field string,
+	value string,
+	maxLength int,
+	regex *regexp.Regexp,
+	validations *commonvalidatorfields.StructFieldsValidations,
+) {
+	if value == "" || !validateLength(field, value, maxLength, validations) {
+		return
+	}
+	if !regex.MatchString(value) {
+		validations.AddFailedFieldValidationError(field, InvalidCharactersError)
+	}
+}
+
+// validateBio checks if the bio is not too long and has no control characters other than line breaks
+func validateBio(
+	field string,
+	value string,
+	validations *commonvalidatorfields.StructFieldsValidations,
+) {
+	if !validateLength(field, value, MaxBioLength, validations) {
+		return
+	}
+	for _, r := range value {
+		if unicode.IsControl(r) && r != '\n' {
+			validations.AddFailedFieldValidationError(field, InvalidCharactersError)
+			return
+		}
+	}
+}
+
+// validateUrl checks if the URL is not too long and is an absolute HTTP or HTTPS URL. An empty value clears the
+// field
+func validateUrl(
+	field string,
+	value string,
+	validations *commonvalidatorfields.StructFieldsValidations,
+) {
+	if value == "" || !validateLength(field, value, MaxUrlLength, validations) {
+		return
+	}
+	parsedUrl, err := url.Parse(value)
+	if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		validations.AddFailedFieldValidationError(field, InvalidUrlError)
+	}
+}
+
+// validateSocialLinks checks if there are not too many social links, and if each one is a unique valid URL
+func validateSocialLinks(
+	field string,
+	links []string,
+	validations *commonvalidatorfields.StructFieldsValidations,
+) {
+	if len(links) > MaxSocialLinks {
+		validations.AddFailedFieldValidationError(field, fmt.Errorf("%w %d", TooManySocialLinksError, MaxSocialLinks))
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, link := range links {
+		if link == "" {
+			validations.AddFailedFieldValidationError(field, InvalidUrlError)
+			continue
+		}
+		if seen[link] {
+			validations.AddFailedFieldValidationError(field, DuplicateSocialLinkError)
+			continue
+		}
+		seen[link] = true
+		validateUrl(field, link, validations)
+	}
+}
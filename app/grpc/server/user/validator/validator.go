@@ -2,12 +2,20 @@ package validator
 
 import (
 	"context"
+	"fmt"
 	commonflag "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/config/flag"
 	commongrpcvalidator "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/validator"
 	commonvalidatorfields "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/validator/fields"
-	pbuser "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/compiled/pixel_plaza/user"
 	appmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb/user"
+	appmfa "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/mfa"
+	apppasskey "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/passkey"
+	apppreferences "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/preferences"
+	appprofile "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/profile"
+	pbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/user"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/codes"
+	"strings"
+	"unicode/utf8"
 )
 
 type (
@@ -15,6 +23,7 @@ type (
 	Validator struct {
 		userDatabase *appmongodbuser.Database
 		validator    commongrpcvalidator.Validator
+		batchLimit   int
 	}
 )
 
@@ -44,6 +53,70 @@ var (
 		&pbuser.GetProfileRequest{},
 		commonflag.Mode,
 	)
+	BatchGetUsernamesByUserIdsRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.BatchGetUsernamesByUserIdsRequest{},
+		commonflag.Mode,
+	)
+	BatchGetUserIdsByUsernamesRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.BatchGetUserIdsByUsernamesRequest{},
+		commonflag.Mode,
+	)
+	SearchUsersRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.SearchUsersRequest{},
+		commonflag.Mode,
+	)
+	UpdateUserRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.UpdateUserRequest{},
+		commonflag.Mode,
+	)
+	UpdateProfileVisibilityRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.UpdateProfileVisibilityRequest{},
+		commonflag.Mode,
+	)
+	UpdatePreferencesRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.UpdatePreferencesRequest{},
+		commonflag.Mode,
+	)
+	BlockUserRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.BlockUserRequest{},
+		commonflag.Mode,
+	)
+	UnblockUserRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.UnblockUserRequest{},
+		commonflag.Mode,
+	)
+	IsBlockedRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.IsBlockedRequest{},
+		commonflag.Mode,
+	)
+	ConfirmTOTPRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.ConfirmTOTPRequest{},
+		commonflag.Mode,
+	)
+	DisableTOTPRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.DisableTOTPRequest{},
+		commonflag.Mode,
+	)
+	VerifySecondFactorRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.VerifySecondFactorRequest{},
+		commonflag.Mode,
+	)
+	FinishPasskeyRegistrationRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.FinishPasskeyRegistrationRequest{},
+		commonflag.Mode,
+	)
+	BeginPasskeyAssertionRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.BeginPasskeyAssertionRequest{},
+		commonflag.Mode,
+	)
+	FinishPasskeyAssertionRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.FinishPasskeyAssertionRequest{},
+		commonflag.Mode,
+	)
+	RevokePasskeyRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.RevokePasskeyRequest{},
+		commonflag.Mode,
+	)
 	ChangeUsernameRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
 		&pbuser.ChangeUsernameRequest{},
 		commonflag.Mode,
@@ -52,6 +125,22 @@ var (
 		&pbuser.ChangePasswordRequest{},
 		commonflag.Mode,
 	)
+	ForgotPasswordRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.ForgotPasswordRequest{},
+		commonflag.Mode,
+	)
+	ResetPasswordRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.ResetPasswordRequest{},
+		commonflag.Mode,
+	)
+	SendVerificationEmailRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.SendVerificationEmailRequest{},
+		commonflag.Mode,
+	)
+	VerifyEmailRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
+		&pbuser.VerifyEmailRequest{},
+		commonflag.Mode,
+	)
 	ChangePhoneNumberRequestFieldsToValidate, _ = commonvalidatorfields.CreateGRPCStructFieldsToValidate(
 		&pbuser.ChangePhoneNumberRequest{},
 		commonflag.Mode,
@@ -78,6 +167,7 @@ var (
 func NewValidator(
 	userDatabase *appmongodbuser.Database,
 	validator commongrpcvalidator.Validator,
+	batchLimit int,
 ) (*Validator, error) {
 	// Check if either the user database or the validator is nil
 	if userDatabase == nil {
@@ -87,17 +177,23 @@ func NewValidator(
 		return nil, commongrpcvalidator.NilValidatorError
 	}
 
-	return &Validator{userDatabase: userDatabase, validator: validator}, nil
+	// Check if the batch limit is positive
+	if batchLimit <= 0 {
+		return nil, NonPositiveBatchLimitError
+	}
+
+	return &Validator{userDatabase: userDatabase, validator: validator, batchLimit: batchLimit}, nil
 }
 
 // UsernameExists checks if the username exists
 func (v *Validator) UsernameExists(
+	ctx context.Context,
 	usernameField string,
 	username string,
 	structFieldsValidations *commonvalidatorfields.StructFieldsValidations,
 ) bool {
 	if exists, _ := v.userDatabase.UsernameExists(
-		context.Background(),
+		ctx,
 		username,
 	); exists {
 		structFieldsValidations.AddFailedFieldValidationError(usernameField, UsernameTakenError)
@@ -107,7 +203,10 @@ func (v *Validator) UsernameExists(
 }
 
 // ValidateSignUpRequest validates the sign up request
-func (v *Validator) ValidateSignUpRequest(request *pbuser.SignUpRequest) error {
+func (v *Validator) ValidateSignUpRequest(
+	ctx context.Context,
+	request *pbuser.SignUpRequest,
+) error {
 	// Get validations from fields to validate
 	validations, _ := v.validator.ValidateNilFields(
 		request,
@@ -116,6 +215,7 @@ func (v *Validator) ValidateSignUpRequest(request *pbuser.SignUpRequest) error {
 
 	// Check if the user already exists
 	usernameExists := v.UsernameExists(
+		ctx,
 		"username",
 		request.GetUsername(),
 		validations,
@@ -193,6 +293,377 @@ func (v *Validator) ValidateGetProfileRequest(request *pbuser.GetProfileRequest)
 	return v.validator.CheckValidations(validations, codes.InvalidArgument)
 }
 
+// validateBatchSize checks if the number of items of a batch lookup is within the batch limit
+func (v *Validator) validateBatchSize(
+	field string,
+	size int,
+	validations *commonvalidatorfields.StructFieldsValidations,
+) {
+	if size > v.batchLimit {
+		validations.AddFailedFieldValidationError(field, fmt.Errorf("%w %d", BatchLimitExceededError, v.batchLimit))
+	}
+}
+
+// ValidateBatchGetUsernamesByUserIdsRequest validates the batch get usernames by user IDs request
+func (v *Validator) ValidateBatchGetUsernamesByUserIdsRequest(request *pbuser.BatchGetUsernamesByUserIdsRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		BatchGetUsernamesByUserIdsRequestFieldsToValidate,
+	)
+
+	// Check if the number of user IDs is within the batch limit
+	v.validateBatchSize("user_ids", len(request.GetUserIds()), validations)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateBatchGetUserIdsByUsernamesRequest validates the batch get user IDs by usernames request
+func (v *Validator) ValidateBatchGetUserIdsByUsernamesRequest(request *pbuser.BatchGetUserIdsByUsernamesRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		BatchGetUserIdsByUsernamesRequestFieldsToValidate,
+	)
+
+	// Check if the number of usernames is within the batch limit
+	v.validateBatchSize("usernames", len(request.GetUsernames()), validations)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateSearchUsersRequest validates the search users request
+func (v *Validator) ValidateSearchUsersRequest(request *pbuser.SearchUsersRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		SearchUsersRequestFieldsToValidate,
+	)
+
+	// Check if the query length is valid
+	queryLength := utf8.RuneCountInString(strings.TrimSpace(request.GetQuery()))
+	if queryLength < MinSearchQueryLength || queryLength > MaxSearchQueryLength {
+		validations.AddFailedFieldValidationError("query", SearchQueryLengthError)
+	}
+
+	// Check if the page size is valid
+	if request.PageSize != nil && (request.GetPageSize() < 1 || request.GetPageSize() > MaxSearchPageSize) {
+		validations.AddFailedFieldValidationError("page_size", PageSizeOutOfRangeError)
+	}
+
+	// Check if the page token is valid
+	if request.PageToken != nil {
+		if _, err := appmongodbuser.DecodeSearchUsersCursor(request.GetPageToken()); err != nil {
+			validations.AddFailedFieldValidationError("page_token", err)
+		}
+	}
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateUpdateUserRequest validates the update user request
+func (v *Validator) ValidateUpdateUserRequest(request *pbuser.UpdateUserRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		UpdateUserRequestFieldsToValidate,
+	)
+
+	// Check if the birthdate is valid
+	if birthdate := request.GetBirthdate(); birthdate != nil {
+		v.validator.ValidateBirthdate("birthdate", birthdate, validations)
+	}
+
+	// Check if the profile fields are valid
+	if request.Bio != nil {
+		validateBio("bio", request.GetBio(), validations)
+	}
+	if request.Location != nil {
+		validateText("location", request.GetLocation(), MaxLocationLength, locationRegex, validations)
+	}
+	if request.Website != nil {
+		validateUrl("website", request.GetWebsite(), validations)
+	}
+	if request.SocialLinks != nil {
+		validateSocialLinks("social_links", request.GetSocialLinks().GetUrls(), validations)
+	}
+	if request.Pronouns != nil {
+		validateText("pronouns", request.GetPronouns(), MaxPronounsLength, pronounsRegex, validations)
+	}
+	if request.DisplayName != nil {
+		validateText("display_name", request.GetDisplayName(), MaxDisplayNameLength, displayNameRegex, validations)
+	}
+
+	// Check if the expected version is valid
+	if request.ExpectedVersion != nil && request.GetExpectedVersion() < 0 {
+		validations.AddFailedFieldValidationError("expected_version", InvalidVersionError)
+	}
+
+	// Check if the update mask paths are allowed, if the update mask is set. The names can't be cleared, so their
+	// paths require a value
+	if updateMask := request.GetUpdateMask(); updateMask != nil {
+		if len(updateMask.GetPaths()) == 0 {
+			validations.AddFailedFieldValidationError("update_mask", MissingUpdateMaskError)
+		}
+		requiredValues := map[string]string{
+			appprofile.FirstName: request.GetFirstName(),
+			appprofile.LastName:  request.GetLastName(),
+		}
+		for _, path := range updateMask.GetPaths() {
+			if !appprofile.IsValidPath(path) {
+				validations.AddFailedFieldValidationError(
+					"update_mask",
+					fmt.Errorf("%w %s", UnknownUpdateMaskPathError, path),
+				)
+			} else if appprofile.IsRequiredPath(path) && strings.TrimSpace(requiredValues[path]) == "" {
+				validations.AddFailedFieldValidationError(
+					"update_mask",
+					fmt.Errorf("%w %s", ClearedRequiredPathError, path),
+				)
+			}
+		}
+	}
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateUpdateProfileVisibilityRequest validates the update profile visibility request
+func (v *Validator) ValidateUpdateProfileVisibilityRequest(request *pbuser.UpdateProfileVisibilityRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		UpdateProfileVisibilityRequestFieldsToValidate,
+	)
+
+	// Check if the settings are set, since nested messages aren't checked by the fields to validate
+	settings := request.GetSettings()
+	if settings == nil {
+		validations.AddFailedFieldValidationError("settings", MissingSettingsError)
+		return v.validator.CheckValidations(validations, codes.InvalidArgument)
+	}
+
+	// Check if the visibility of each field is valid
+	fields := map[string]*pbuser.ProfileVisibility{
+		"settings.first_name":   settings.FirstName,
+		"settings.last_name":    settings.LastName,
+		"settings.birthdate":    settings.Birthdate,
+		"settings.joined_at":    settings.JoinedAt,
+		"settings.bio":          settings.Bio,
+		"settings.location":     settings.Location,
+		"settings.website":      settings.Website,
+		"settings.social_links": settings.SocialLinks,
+		"settings.pronouns":     settings.Pronouns,
+		"settings.display_name": settings.DisplayName,
+	}
+	for field, visibility := range fields {
+		if visibility == nil {
+			continue
+		}
+		if _, ok := pbuser.ProfileVisibility_name[int32(*visibility)]; !ok {
+			validations.AddFailedFieldValidationError(field, InvalidVisibilityError)
+		}
+	}
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateUpdatePreferencesRequest validates the update preferences request
+func (v *Validator) ValidateUpdatePreferencesRequest(request *pbuser.UpdatePreferencesRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		UpdatePreferencesRequestFieldsToValidate,
+	)
+
+	// Check if the preferences and the update mask are set, since nested messages aren't checked by the fields to
+	// validate
+	preferences := request.GetPreferences()
+	if preferences == nil {
+		validations.AddFailedFieldValidationError("preferences", MissingPreferencesError)
+	}
+	paths := request.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		validations.AddFailedFieldValidationError("update_mask", MissingUpdateMaskError)
+	}
+	if validations.HasFailed() {
+		return v.validator.CheckValidations(validations, codes.InvalidArgument)
+	}
+
+	// Check if the update mask paths are allowed
+	for _, path := range paths {
+		if !apppreferences.IsValidPath(path) {
+			validations.AddFailedFieldValidationError(
+				"update_mask",
+				fmt.Errorf("%w %s", UnknownUpdateMaskPathError, path),
+			)
+		}
+	}
+
+	// Check if the values of the updated keys are valid
+	for _, key := range apppreferences.Keys(paths) {
+		switch key {
+		case apppreferences.Locale:
+			if !apppreferences.IsValidLocale(preferences.GetLocale()) {
+				validations.AddFailedFieldValidationError("preferences.locale", InvalidLocaleError)
+			}
+		case apppreferences.Timezone:
+			if !apppreferences.IsValidTimezone(preferences.GetTimezone()) {
+				validations.AddFailedFieldValidationError("preferences.timezone", InvalidTimezoneError)
+			}
+		case apppreferences.Currency:
+			if !apppreferences.IsValidCurrency(preferences.GetCurrency()) {
+				validations.AddFailedFieldValidationError("preferences.currency", InvalidCurrencyError)
+			}
+		}
+	}
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateBlockUserRequest validates the block user request
+func (v *Validator) ValidateBlockUserRequest(request *pbuser.BlockUserRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		BlockUserRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateUnblockUserRequest validates the unblock user request
+func (v *Validator) ValidateUnblockUserRequest(request *pbuser.UnblockUserRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		UnblockUserRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateIsBlockedRequest validates the is blocked request
+func (v *Validator) ValidateIsBlockedRequest(request *pbuser.IsBlockedRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		IsBlockedRequestFieldsToValidate,
+	)
+
+	// Check if the user IDs are valid
+	for field, userId := range map[string]string{
+		"user_id":       request.GetUserId(),
+		"other_user_id": request.GetOtherUserId(),
+	} {
+		if userId == "" {
+			continue
+		}
+		if _, err := primitive.ObjectIDFromHex(userId); err != nil {
+			validations.AddFailedFieldValidationError(field, InvalidUserIdError)
+		}
+	}
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateConfirmTOTPRequest validates the confirm TOTP request
+func (v *Validator) ValidateConfirmTOTPRequest(request *pbuser.ConfirmTOTPRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		ConfirmTOTPRequestFieldsToValidate,
+	)
+
+	// Check if the code is a TOTP code, since the recovery codes are generated after the confirmation
+	if code := request.GetCode(); code != "" && !appmfa.IsTOTPCode(code) {
+		validations.AddFailedFieldValidationError("code", InvalidTOTPCodeError)
+	}
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateDisableTOTPRequest validates the disable TOTP request
+func (v *Validator) ValidateDisableTOTPRequest(request *pbuser.DisableTOTPRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		DisableTOTPRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateVerifySecondFactorRequest validates the verify second factor request
+func (v *Validator) ValidateVerifySecondFactorRequest(request *pbuser.VerifySecondFactorRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		VerifySecondFactorRequestFieldsToValidate,
+	)
+
+	// Check if the user ID is valid
+	if userId := request.GetUserId(); userId != "" {
+		if _, err := primitive.ObjectIDFromHex(userId); err != nil {
+			validations.AddFailedFieldValidationError("user_id", InvalidUserIdError)
+		}
+	}
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateFinishPasskeyRegistrationRequest validates the finish passkey registration request
+func (v *Validator) ValidateFinishPasskeyRegistrationRequest(request *pbuser.FinishPasskeyRegistrationRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		FinishPasskeyRegistrationRequestFieldsToValidate,
+	)
+
+	// Check the passkey name
+	validateText(
+		"name",
+		strings.TrimSpace(request.GetName()),
+		apppasskey.MaxNameLength,
+		displayNameRegex,
+		validations,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateBeginPasskeyAssertionRequest validates the begin passkey assertion request
+func (v *Validator) ValidateBeginPasskeyAssertionRequest(request *pbuser.BeginPasskeyAssertionRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		BeginPasskeyAssertionRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateFinishPasskeyAssertionRequest validates the finish passkey assertion request
+func (v *Validator) ValidateFinishPasskeyAssertionRequest(request *pbuser.FinishPasskeyAssertionRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		FinishPasskeyAssertionRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateRevokePasskeyRequest validates the revoke passkey request
+func (v *Validator) ValidateRevokePasskeyRequest(request *pbuser.RevokePasskeyRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		RevokePasskeyRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
 // ValidateChangeUsernameRequest validates the change username request
 func (v *Validator) ValidateChangeUsernameRequest(request *pbuser.ChangeUsernameRequest) error {
 	// Get validations from fields to validate
@@ -220,6 +691,50 @@ func (v *Validator) ValidateChangePasswordRequest(request *pbuser.ChangePassword
 	return v.validator.CheckValidations(validations, codes.InvalidArgument)
 }
 
+// ValidateForgotPasswordRequest validates the forgot password request
+func (v *Validator) ValidateForgotPasswordRequest(request *pbuser.ForgotPasswordRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		ForgotPasswordRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateResetPasswordRequest validates the reset password request
+func (v *Validator) ValidateResetPasswordRequest(request *pbuser.ResetPasswordRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		ResetPasswordRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateSendVerificationEmailRequest validates the send verification email request
+func (v *Validator) ValidateSendVerificationEmailRequest(request *pbuser.SendVerificationEmailRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		SendVerificationEmailRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
+// ValidateVerifyEmailRequest validates the verify email request
+func (v *Validator) ValidateVerifyEmailRequest(request *pbuser.VerifyEmailRequest) error {
+	// Get validations from fields to validate
+	validations, _ := v.validator.ValidateNilFields(
+		request,
+		VerifyEmailRequestFieldsToValidate,
+	)
+
+	return v.validator.CheckValidations(validations, codes.InvalidArgument)
+}
+
 // ValidateChangePhoneNumberRequest validates the change phone number request
 func (v *Validator) ValidateChangePhoneNumberRequest(request *pbuser.ChangePhoneNumberRequest) error {
 	// Get validations from fields to validate
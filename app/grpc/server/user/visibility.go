@@ -0,0 +1,76 @@
+package user
+
+import (
+	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
+	appvisibility "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/visibility"
+	pbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/user"
+	"golang.org/x/net/context"
+)
+
+// profileVisibilityLevels maps the gRPC profile visibility to the stored visibility level
+var profileVisibilityLevels = map[pbuser.ProfileVisibility]string{
+	pbuser.ProfileVisibility_PROFILE_VISIBILITY_PUBLIC:        appvisibility.Public,
+	pbuser.ProfileVisibility_PROFILE_VISIBILITY_AUTHENTICATED: appvisibility.Authenticated,
+	pbuser.ProfileVisibility_PROFILE_VISIBILITY_PRIVATE:       appvisibility.Private,
+}
+
+// toProfileVisibility converts the stored visibility level to the gRPC profile visibility
+func toProfileVisibility(level string) *pbuser.ProfileVisibility {
+	for visibility, storedLevel := range profileVisibilityLevels {
+		if storedLevel == level {
+			return &visibility
+		}
+	}
+	return nil
+}
+
+// toProfileVisibilitySettings converts the stored visibility settings to the gRPC settings, filling the fields the
+// user has not set with their default visibility
+func toProfileVisibilitySettings(settings map[string]string) *pbuser.ProfileVisibilitySettings {
+	return &pbuser.ProfileVisibilitySettings{
+		FirstName:   toProfileVisibility(appvisibility.Get(settings, appvisibility.FirstName)),
+		LastName:    toProfileVisibility(appvisibility.Get(settings, appvisibility.LastName)),
+		Birthdate:   toProfileVisibility(appvisibility.Get(settings, appvisibility.Birthdate)),
+		JoinedAt:    toProfileVisibility(appvisibility.Get(settings, appvisibility.JoinedAt)),
+		Bio:         toProfileVisibility(appvisibility.Get(settings, appvisibility.Bio)),
+		Location:    toProfileVisibility(appvisibility.Get(settings, appvisibility.Location)),
+		Website:     toProfileVisibility(appvisibility.Get(settings, appvisibility.Website)),
+		SocialLinks: toProfileVisibility(appvisibility.Get(settings, appvisibility.SocialLinks)),
+		Pronouns:    toProfileVisibility(appvisibility.Get(settings, appvisibility.Pronouns)),
+		DisplayName: toProfileVisibility(appvisibility.Get(settings, appvisibility.DisplayName)),
+	}
+}
+
+// fromProfileVisibilitySettings converts the gRPC settings to the visibility settings to store, skipping the unset
+// and unspecified fields
+func fromProfileVisibilitySettings(settings *pbuser.ProfileVisibilitySettings) map[string]string {
+	fields := map[string]*pbuser.ProfileVisibility{
+		appvisibility.FirstName:   settings.FirstName,
+		appvisibility.LastName:    settings.LastName,
+		appvisibility.Birthdate:   settings.Birthdate,
+		appvisibility.JoinedAt:    settings.JoinedAt,
+		appvisibility.Bio:         settings.Bio,
+		appvisibility.Location:    settings.Location,
+		appvisibility.Website:     settings.Website,
+		appvisibility.SocialLinks: settings.SocialLinks,
+		appvisibility.Pronouns:    settings.Pronouns,
+		appvisibility.DisplayName: settings.DisplayName,
+	}
+
+	levels := make(map[string]string)
+	for field, visibility := range fields {
+		if visibility == nil {
+			continue
+		}
+		if level, ok := profileVisibilityLevels[*visibility]; ok {
+			levels[field] = level
+		}
+	}
+	return levels
+}
+
+// isAuthenticated checks if the caller sent a valid access token to a method that doesn't require it
+func isAuthenticated(ctx context.Context) bool {
+	claims, err := commongrpcserverctx.GetCtxTokenClaims(ctx)
+	return err == nil && claims != nil
+}
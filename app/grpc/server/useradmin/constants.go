@@ -0,0 +1,31 @@
+package useradmin
+
+import "time"
+
+const (
+	FoundUser                    = "user found"
+	NotFoundUser                 = "user not found"
+	NotDeletedUser               = "user not found or not deleted"
+	FetchedUserEmailHistory      = "fetched user email history successfully"
+	FetchedUserPhoneHistory      = "fetched user phone number history successfully"
+	FetchedUserUsernameHistory   = "fetched user username history successfully"
+	LockedUser                   = "user locked successfully"
+	UnlockedUser                 = "user unlocked successfully"
+	ForcedPasswordReset          = "password reset forced successfully"
+	RestoredUser                 = "user restored successfully"
+	FetchedUserRoles             = "fetched user roles successfully"
+	GrantedUserRole              = "user role granted successfully"
+	RevokedUserRole              = "user role revoked successfully"
+	InvalidUserId                = "invalid user id"
+	MissingIdentifier            = "one of user id, username, email or phone number is required"
+	MissingReason                = "reason is required"
+	InvalidRole                  = "invalid role, must be one of buyer, seller, moderator or admin"
+	EmailsAreDisabled            = "emails are not enabled, the user couldn't get a password reset link"
+	SessionRevocationUnsupported = "the auth service can't revoke the sessions of another user yet"
+	RoleNotRegistered            = "role is not registered in the auth service"
+)
+
+const (
+	// AuditTimeout is the timeout to insert an audit log, which is inserted even if the request was canceled
+	AuditTimeout = 5 * time.Second
+)
@@ -0,0 +1,147 @@
+package useradmin
+
+import (
+	"context"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	"google.golang.org/grpc/codes"
+	"log/slog"
+)
+
+// Logger is the logger for the user admin server, with a typed helper for each event
+type Logger struct {
+	logger *slog.Logger
+}
+
+// NewLogger creates a new user admin server logger
+func NewLogger(logger *slog.Logger) (*Logger, error) {
+	// Check if the logger is nil
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &Logger{logger: logger}, nil
+}
+
+// success logs a successful action
+func (l *Logger) success(ctx context.Context, message string, userId string) {
+	l.logger.LogAttrs(ctx, slog.LevelInfo, message, appstructuredlogger.UserId(userId))
+}
+
+// UserFound logs the user look up success
+func (l *Logger) UserFound(ctx context.Context, userId string) {
+	l.success(ctx, "User looked up", userId)
+}
+
+// UserNotFound logs the user look up failure
+func (l *Logger) UserNotFound(ctx context.Context, action pbtypesgrpc.Method) {
+	l.logger.LogAttrs(ctx, slog.LevelWarn, "User not found", appstructuredlogger.Method(action.String()))
+}
+
+// FetchedUserHistory logs the user history retrieval
+func (l *Logger) FetchedUserHistory(ctx context.Context, action pbtypesgrpc.Method, userId string) {
+	l.logger.LogAttrs(
+		ctx,
+		slog.LevelInfo,
+		"Fetched user history",
+		appstructuredlogger.Method(action.String()),
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// LockedUser logs the user lock
+func (l *Logger) LockedUser(ctx context.Context, userId string) {
+	l.success(ctx, "User locked", userId)
+}
+
+// UnlockedUser logs the user unlock
+func (l *Logger) UnlockedUser(ctx context.Context, userId string) {
+	l.success(ctx, "User unlocked", userId)
+}
+
+// ForcedPasswordReset logs the forced password reset
+func (l *Logger) ForcedPasswordReset(ctx context.Context, userId string) {
+	l.success(ctx, "User password reset forced", userId)
+}
+
+// EmailsAreDisabled logs an action that needs the emails while they are not enabled
+func (l *Logger) EmailsAreDisabled(ctx context.Context, action pbtypesgrpc.Method) {
+	l.logger.LogAttrs(ctx, slog.LevelWarn, "Emails are disabled", appstructuredlogger.Method(action.String()))
+}
+
+// RestoredUser logs the user restoration
+func (l *Logger) RestoredUser(ctx context.Context, userId string) {
+	l.success(ctx, "User restored", userId)
+}
+
+// SessionRevocationUnsupported logs the user sessions revocation that the auth service can't do
+func (l *Logger) SessionRevocationUnsupported(ctx context.Context, userId string) {
+	l.logger.LogAttrs(
+		ctx,
+		slog.LevelWarn,
+		"User sessions revocation is not supported",
+		appstructuredlogger.UserId(userId),
+	)
+}
+
+// FetchedUserRoles logs the user roles retrieval
+func (l *Logger) FetchedUserRoles(ctx context.Context, userId string) {
+	l.success(ctx, "Fetched user roles", userId)
+}
+
+// GrantedUserRole logs the user role grant
+func (l *Logger) GrantedUserRole(ctx context.Context, userId string, role string) {
+	l.logger.LogAttrs(
+		ctx,
+		slog.LevelInfo,
+		"User role granted",
+		appstructuredlogger.UserId(userId),
+		slog.String("role", role),
+	)
+}
+
+// RevokedUserRole logs the user role revocation
+func (l *Logger) RevokedUserRole(ctx context.Context, userId string, role string) {
+	l.logger.LogAttrs(
+		ctx,
+		slog.LevelInfo,
+		"User role revoked",
+		appstructuredlogger.UserId(userId),
+		slog.String("role", role),
+	)
+}
+
+// ActionFailed logs the failure of an action
+func (l *Logger) ActionFailed(ctx context.Context, action pbtypesgrpc.Method, err error) {
+	l.logger.LogAttrs(
+		ctx,
+		slog.LevelError,
+		"User admin action failed",
+		appstructuredlogger.Method(action.String()),
+		appstructuredlogger.Error(err),
+	)
+}
+
+// Audited logs the audit of an action
+func (l *Logger) Audited(ctx context.Context, action pbtypesgrpc.Method, userId string, code codes.Code) {
+	l.logger.LogAttrs(
+		ctx,
+		slog.LevelDebug,
+		"User admin action audited",
+		appstructuredlogger.Method(action.String()),
+		appstructuredlogger.UserId(userId),
+		appstructuredlogger.Code(code.String()),
+	)
+}
+
+// FailedToAudit logs the audit failure of an action
+func (l *Logger) FailedToAudit(ctx context.Context, action pbtypesgrpc.Method, err error) {
+	l.logger.LogAttrs(
+		ctx,
+		slog.LevelError,
+		"Failed to audit user admin action",
+		appstructuredlogger.Method(action.String()),
+		appstructuredlogger.Error(err),
+	)
+}
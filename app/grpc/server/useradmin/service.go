@@ -0,0 +1,552 @@
+package useradmin
+
+import (
+	"context"
+	"errors"
+	commongrpcserverctx "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/context"
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	appmongodbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb/user"
+	appgrpcclientctx "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/client/context"
+	appgrpcrequestid "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/requestid"
+	userserver "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/user"
+	appmail "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/mail"
+	approle "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/role"
+	pbuseradmin "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/useradmin"
+	pbconfiguseradmin "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/useradmin"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// Server is the gRPC user admin server, used by the support staff
+type Server struct {
+	userDatabase *appmongodbuser.Database
+	roleClient   *approle.Client
+	mailer       *appmail.Mailer
+	logger       *Logger
+	pbuseradmin.UnimplementedUserAdminServer
+}
+
+// NewServer creates a new gRPC user admin server
+func NewServer(
+	userDatabase *appmongodbuser.Database,
+	roleClient *approle.Client,
+	mailer *appmail.Mailer,
+	logger *Logger,
+) *Server {
+	return &Server{
+		userDatabase: userDatabase,
+		roleClient:   roleClient,
+		mailer:       mailer,
+		logger:       logger,
+	}
+}
+
+// audit inserts the action into the audit log with its resulting status code
+func (s *Server) audit(
+	ctx context.Context,
+	action pbtypesgrpc.Method,
+	userId string,
+	reason string,
+	err error,
+) {
+	s.insertAuditLog(ctx, action, &appmongodbuser.UserAdminAuditLog{UserID: userId, Reason: reason}, err)
+}
+
+// auditRole inserts the role action into the audit log with its resulting status code
+func (s *Server) auditRole(
+	ctx context.Context,
+	action pbtypesgrpc.Method,
+	userId string,
+	role string,
+	reason string,
+	err error,
+) {
+	s.insertAuditLog(
+		ctx,
+		action,
+		&appmongodbuser.UserAdminAuditLog{UserID: userId, Role: role, Reason: reason},
+		err,
+	)
+}
+
+// insertAuditLog completes the audit log with the admin user ID, the request ID and the status code, and inserts it
+func (s *Server) insertAuditLog(
+	ctx context.Context,
+	action pbtypesgrpc.Method,
+	auditLog *appmongodbuser.UserAdminAuditLog,
+	err error,
+) {
+	// Get the admin user ID and the request ID
+	auditLog.AdminID, _ = commongrpcserverctx.GetCtxTokenClaimsUserId(ctx)
+	auditLog.RequestID, _ = appgrpcrequestid.FromContext(ctx)
+	auditLog.Action = action.String()
+	code := status.Code(err)
+	auditLog.Code = code.String()
+
+	// Insert the audit log even if the request was canceled
+	auditCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), AuditTimeout)
+	defer cancel()
+
+	if err = s.userDatabase.InsertUserAdminAuditLog(auditCtx, auditLog); err != nil {
+		s.logger.FailedToAudit(ctx, action, err)
+		return
+	}
+	s.logger.Audited(ctx, action, auditLog.UserID, code)
+}
+
+// failed logs the error of the action and returns the status error sent to the support staff
+func (s *Server) failed(
+	ctx context.Context,
+	action pbtypesgrpc.Method,
+	err error,
+	notFoundMessage string,
+) error {
+	// Check if the user was not found
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.UserNotFound(ctx, action)
+		return status.Error(codes.NotFound, notFoundMessage)
+	}
+
+	// Check if the role is not registered in the auth service
+	if errors.Is(err, approle.RoleNotRegisteredError) {
+		s.logger.ActionFailed(ctx, action, err)
+		return status.Error(codes.FailedPrecondition, RoleNotRegistered)
+	}
+
+	s.logger.ActionFailed(ctx, action, err)
+	return userserver.InternalError(ctx, err)
+}
+
+// LookUpUser looks up a user by ID, username, email or phone number, including the deleted ones
+func (s *Server) LookUpUser(
+	ctx context.Context,
+	request *pbuseradmin.LookUpUserRequest,
+) (response *pbuseradmin.LookUpUserResponse, err error) {
+	var userId string
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.LookUpUser, userId, "", err)
+	}()
+
+	// Validate the request
+	if err = validateLookUpUserRequest(request); err != nil {
+		return nil, err
+	}
+
+	// Find the user by the given identifier
+	var user *appmongodbuser.User
+	switch request.GetIdentifier().(type) {
+	case *pbuseradmin.LookUpUserRequest_UserId:
+		user, err = s.userDatabase.FindAdminUserByUserId(ctx, request.GetUserId())
+	case *pbuseradmin.LookUpUserRequest_Username:
+		user, err = s.userDatabase.FindAdminUserByUsername(ctx, request.GetUsername())
+	case *pbuseradmin.LookUpUserRequest_Email:
+		user, err = s.userDatabase.FindAdminUserByEmail(ctx, request.GetEmail())
+	case *pbuseradmin.LookUpUserRequest_PhoneNumber:
+		user, err = s.userDatabase.FindAdminUserByPhoneNumber(ctx, request.GetPhoneNumber())
+	}
+	if err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.LookUpUser, err, NotFoundUser)
+	}
+
+	// User found
+	userId = user.ID.Hex()
+	s.logger.UserFound(ctx, userId)
+
+	return &pbuseradmin.LookUpUserResponse{
+		Message: FoundUser,
+		User:    newUser(user),
+	}, nil
+}
+
+// GetUserEmailHistory gets every email assigned to the user
+func (s *Server) GetUserEmailHistory(
+	ctx context.Context,
+	request *pbuseradmin.GetUserEmailHistoryRequest,
+) (response *pbuseradmin.GetUserEmailHistoryResponse, err error) {
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.GetUserEmailHistory, request.GetUserId(), "", err)
+	}()
+
+	// Validate the request
+	if err = validateUserId(request.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	// Get the user's email history
+	emails, err := s.userDatabase.GetUserEmailHistory(ctx, request.GetUserId())
+	if err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.GetUserEmailHistory, err, NotFoundUser)
+	}
+
+	// Fetched the user's email history
+	s.logger.FetchedUserHistory(ctx, pbconfiguseradmin.GetUserEmailHistory, request.GetUserId())
+
+	response = &pbuseradmin.GetUserEmailHistoryResponse{Message: FetchedUserEmailHistory}
+	for _, email := range emails {
+		response.Emails = append(
+			response.Emails, &pbuseradmin.UserEmail{
+				Email:      email.Email,
+				IsPrimary:  email.IsPrimary,
+				AssignedAt: timestamppb.New(email.AssignedAt),
+				VerifiedAt: optionalTimestamp(email.VerifiedAt),
+				RevokedAt:  optionalTimestamp(email.RevokedAt),
+			},
+		)
+	}
+	return response, nil
+}
+
+// GetUserPhoneNumberHistory gets every phone number assigned to the user
+func (s *Server) GetUserPhoneNumberHistory(
+	ctx context.Context,
+	request *pbuseradmin.GetUserPhoneNumberHistoryRequest,
+) (response *pbuseradmin.GetUserPhoneNumberHistoryResponse, err error) {
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.GetUserPhoneNumberHistory, request.GetUserId(), "", err)
+	}()
+
+	// Validate the request
+	if err = validateUserId(request.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	// Get the user's phone number history
+	phoneNumbers, err := s.userDatabase.GetUserPhoneNumberHistory(ctx, request.GetUserId())
+	if err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.GetUserPhoneNumberHistory, err, NotFoundUser)
+	}
+
+	// Fetched the user's phone number history
+	s.logger.FetchedUserHistory(ctx, pbconfiguseradmin.GetUserPhoneNumberHistory, request.GetUserId())
+
+	response = &pbuseradmin.GetUserPhoneNumberHistoryResponse{Message: FetchedUserPhoneHistory}
+	for _, phoneNumber := range phoneNumbers {
+		response.PhoneNumbers = append(
+			response.PhoneNumbers, &pbuseradmin.UserPhoneNumber{
+				PhoneNumber: phoneNumber.PhoneNumber,
+				AssignedAt:  timestamppb.New(phoneNumber.AssignedAt),
+				VerifiedAt:  optionalTimestamp(phoneNumber.VerifiedAt),
+				RevokedAt:   optionalTimestamp(phoneNumber.RevokedAt),
+			},
+		)
+	}
+	return response, nil
+}
+
+// GetUserUsernameHistory gets every username assigned to the user
+func (s *Server) GetUserUsernameHistory(
+	ctx context.Context,
+	request *pbuseradmin.GetUserUsernameHistoryRequest,
+) (response *pbuseradmin.GetUserUsernameHistoryResponse, err error) {
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.GetUserUsernameHistory, request.GetUserId(), "", err)
+	}()
+
+	// Validate the request
+	if err = validateUserId(request.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	// Get the user's username history
+	usernames, err := s.userDatabase.GetUserUsernameHistory(ctx, request.GetUserId())
+	if err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.GetUserUsernameHistory, err, NotFoundUser)
+	}
+
+	// Fetched the user's username history
+	s.logger.FetchedUserHistory(ctx, pbconfiguseradmin.GetUserUsernameHistory, request.GetUserId())
+
+	response = &pbuseradmin.GetUserUsernameHistoryResponse{Message: FetchedUserUsernameHistory}
+	for _, username := range usernames {
+		response.Usernames = append(
+			response.Usernames, &pbuseradmin.UserUsername{
+				Username:   username.Username,
+				AssignedAt: timestamppb.New(username.AssignedAt),
+			},
+		)
+	}
+	return response, nil
+}
+
+// LockUser locks the user account. The locked user can't log in, but the tokens issued before the lock stay valid
+// until they expire, since the auth service can't revoke the sessions of another user
+func (s *Server) LockUser(
+	ctx context.Context,
+	request *pbuseradmin.LockUserRequest,
+) (response *pbuseradmin.LockUserResponse, err error) {
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.LockUser, request.GetUserId(), request.GetReason(), err)
+	}()
+
+	// Validate the request
+	if err = validateAction(request.GetUserId(), request.GetReason()); err != nil {
+		return nil, err
+	}
+
+	// Lock the user account
+	if err = s.userDatabase.LockUser(ctx, request.GetUserId(), request.GetReason()); err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.LockUser, err, NotFoundUser)
+	}
+
+	// Locked the user account
+	s.logger.LockedUser(ctx, request.GetUserId())
+
+	return &pbuseradmin.LockUserResponse{
+		Message: LockedUser,
+	}, nil
+}
+
+// UnlockUser unlocks the user account
+func (s *Server) UnlockUser(
+	ctx context.Context,
+	request *pbuseradmin.UnlockUserRequest,
+) (response *pbuseradmin.UnlockUserResponse, err error) {
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.UnlockUser, request.GetUserId(), request.GetReason(), err)
+	}()
+
+	// Validate the request
+	if err = validateAction(request.GetUserId(), request.GetReason()); err != nil {
+		return nil, err
+	}
+
+	// Unlock the user account
+	if err = s.userDatabase.UnlockUser(ctx, request.GetUserId()); err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.UnlockUser, err, NotFoundUser)
+	}
+
+	// Unlocked the user account
+	s.logger.UnlockedUser(ctx, request.GetUserId())
+
+	return &pbuseradmin.UnlockUserResponse{
+		Message: UnlockedUser,
+	}, nil
+}
+
+// ForcePasswordReset requires the user to reset the password before logging in again. The tokens issued before stay
+// valid until they expire, since the auth service can't revoke the sessions of another user
+func (s *Server) ForcePasswordReset(
+	ctx context.Context,
+	request *pbuseradmin.ForcePasswordResetRequest,
+) (response *pbuseradmin.ForcePasswordResetResponse, err error) {
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.ForcePasswordReset, request.GetUserId(), request.GetReason(), err)
+	}()
+
+	// Validate the request
+	if err = validateAction(request.GetUserId(), request.GetReason()); err != nil {
+		return nil, err
+	}
+
+	// Check if the emails are enabled, otherwise the user couldn't get a password reset link to log in again
+	if s.mailer == nil {
+		s.logger.EmailsAreDisabled(ctx, pbconfiguseradmin.ForcePasswordReset)
+		return nil, status.Error(codes.FailedPrecondition, EmailsAreDisabled)
+	}
+
+	// Require the user to reset the password
+	if err = s.userDatabase.RequirePasswordReset(ctx, request.GetUserId()); err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.ForcePasswordReset, err, NotFoundUser)
+	}
+
+	// Forced the user's password reset
+	s.logger.ForcedPasswordReset(ctx, request.GetUserId())
+
+	return &pbuseradmin.ForcePasswordResetResponse{
+		Message: ForcedPasswordReset,
+	}, nil
+}
+
+// RestoreUser restores a deleted user
+func (s *Server) RestoreUser(
+	ctx context.Context,
+	request *pbuseradmin.RestoreUserRequest,
+) (response *pbuseradmin.RestoreUserResponse, err error) {
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.RestoreUser, request.GetUserId(), request.GetReason(), err)
+	}()
+
+	// Validate the request
+	if err = validateAction(request.GetUserId(), request.GetReason()); err != nil {
+		return nil, err
+	}
+
+	// Restore the user
+	if err = s.userDatabase.RestoreUser(ctx, request.GetUserId()); err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.RestoreUser, err, NotDeletedUser)
+	}
+
+	// Restored the user
+	s.logger.RestoredUser(ctx, request.GetUserId())
+
+	return &pbuseradmin.RestoreUserResponse{
+		Message: RestoredUser,
+	}, nil
+}
+
+// RevokeUserSessions is not supported until the auth service can revoke the refresh tokens of another user, it
+// only exposes the pbauth RevokeRefreshTokens RPC that revokes the caller's tokens
+func (s *Server) RevokeUserSessions(
+	ctx context.Context,
+	request *pbuseradmin.RevokeUserSessionsRequest,
+) (response *pbuseradmin.RevokeUserSessionsResponse, err error) {
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.RevokeUserSessions, request.GetUserId(), request.GetReason(), err)
+	}()
+
+	// Validate the request
+	if err = validateAction(request.GetUserId(), request.GetReason()); err != nil {
+		return nil, err
+	}
+
+	s.logger.SessionRevocationUnsupported(ctx, request.GetUserId())
+	return nil, status.Error(codes.Unimplemented, SessionRevocationUnsupported)
+}
+
+// roleCtx checks if the user exists and returns the outgoing context of the calls to the auth service, which stores
+// the user roles and checks the caller's access token
+func (s *Server) roleCtx(ctx context.Context, userId string) (context.Context, error) {
+	// Check if the user exists
+	if _, err := s.userDatabase.FindAdminUserByUserId(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	// Get outgoing gRPC context
+	return appgrpcclientctx.GetOutgoingCtx(ctx)
+}
+
+// ListUserRoles lists the user's roles
+func (s *Server) ListUserRoles(
+	ctx context.Context,
+	request *pbuseradmin.ListUserRolesRequest,
+) (response *pbuseradmin.ListUserRolesResponse, err error) {
+	defer func() {
+		s.audit(ctx, pbconfiguseradmin.ListUserRoles, request.GetUserId(), "", err)
+	}()
+
+	// Validate the request
+	if err = validateUserId(request.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	// Get the user's roles
+	grpcCtx, err := s.roleCtx(ctx, request.GetUserId())
+	if err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.ListUserRoles, err, NotFoundUser)
+	}
+	roles, err := s.roleClient.GetUserRoles(grpcCtx, request.GetUserId())
+	if err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.ListUserRoles, err, NotFoundUser)
+	}
+
+	// Fetched the user's roles
+	s.logger.FetchedUserRoles(ctx, request.GetUserId())
+
+	return &pbuseradmin.ListUserRolesResponse{
+		Message: FetchedUserRoles,
+		Roles:   roles,
+	}, nil
+}
+
+// GrantUserRole grants the role to the user through the auth service
+func (s *Server) GrantUserRole(
+	ctx context.Context,
+	request *pbuseradmin.GrantUserRoleRequest,
+) (response *pbuseradmin.GrantUserRoleResponse, err error) {
+	defer func() {
+		s.auditRole(
+			ctx,
+			pbconfiguseradmin.GrantUserRole,
+			request.GetUserId(),
+			request.GetRole(),
+			request.GetReason(),
+			err,
+		)
+	}()
+
+	// Validate the request
+	if err = validateRoleAction(request.GetUserId(), request.GetRole(), request.GetReason()); err != nil {
+		return nil, err
+	}
+
+	// Grant the role to the user
+	grpcCtx, err := s.roleCtx(ctx, request.GetUserId())
+	if err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.GrantUserRole, err, NotFoundUser)
+	}
+	if err = s.roleClient.AddUserRole(grpcCtx, request.GetUserId(), request.GetRole()); err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.GrantUserRole, err, NotFoundUser)
+	}
+
+	// Granted the user's role
+	s.logger.GrantedUserRole(ctx, request.GetUserId(), request.GetRole())
+
+	return &pbuseradmin.GrantUserRoleResponse{
+		Message: GrantedUserRole,
+	}, nil
+}
+
+// RevokeUserRole revokes the role from the user through the auth service
+func (s *Server) RevokeUserRole(
+	ctx context.Context,
+	request *pbuseradmin.RevokeUserRoleRequest,
+) (response *pbuseradmin.RevokeUserRoleResponse, err error) {
+	defer func() {
+		s.auditRole(
+			ctx,
+			pbconfiguseradmin.RevokeUserRole,
+			request.GetUserId(),
+			request.GetRole(),
+			request.GetReason(),
+			err,
+		)
+	}()
+
+	// Validate the request
+	if err = validateRoleAction(request.GetUserId(), request.GetRole(), request.GetReason()); err != nil {
+		return nil, err
+	}
+
+	// Revoke the role from the user
+	grpcCtx, err := s.roleCtx(ctx, request.GetUserId())
+	if err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.RevokeUserRole, err, NotFoundUser)
+	}
+	if err = s.roleClient.RevokeUserRole(grpcCtx, request.GetUserId(), request.GetRole()); err != nil {
+		return nil, s.failed(ctx, pbconfiguseradmin.RevokeUserRole, err, NotFoundUser)
+	}
+
+	// Revoked the user's role
+	s.logger.RevokedUserRole(ctx, request.GetUserId(), request.GetRole())
+
+	return &pbuseradmin.RevokeUserRoleResponse{
+		Message: RevokedUserRole,
+	}, nil
+}
+
+// newUser converts the MongoDB user model to the user shown to the support staff
+func newUser(user *appmongodbuser.User) *pbuseradmin.User {
+	return &pbuseradmin.User{
+		UserId:                user.ID.Hex(),
+		Username:              user.Username,
+		FirstName:             user.FirstName,
+		LastName:              user.LastName,
+		Birthdate:             optionalTimestamp(user.Birthdate),
+		JoinedAt:              timestamppb.New(user.JoinedAt),
+		DeletedAt:             optionalTimestamp(user.DeletedAt),
+		LockedAt:              optionalTimestamp(user.LockedAt),
+		LockReason:            user.LockReason,
+		PasswordResetRequired: user.PasswordResetRequired,
+	}
+}
+
+// optionalTimestamp converts the time to a timestamp, or nil if the time is not set
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
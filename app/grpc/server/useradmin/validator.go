@@ -0,0 +1,53 @@
+package useradmin
+
+import (
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	approle "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/role"
+	pbuseradmin "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/useradmin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// validateUserId checks if the user ID is a valid object ID
+func validateUserId(userId string) error {
+	if _, err := commonmongodb.GetObjectIdFromString(userId); err != nil {
+		return status.Error(codes.InvalidArgument, InvalidUserId)
+	}
+	return nil
+}
+
+// validateAction checks the user ID and the reason of an action that changes the user account
+func validateAction(userId string, reason string) error {
+	if err := validateUserId(userId); err != nil {
+		return err
+	}
+	if strings.TrimSpace(reason) == "" {
+		return status.Error(codes.InvalidArgument, MissingReason)
+	}
+	return nil
+}
+
+// validateRoleAction checks the user ID, the role and the reason of an action that changes the user roles
+func validateRoleAction(userId string, role string, reason string) error {
+	if err := validateAction(userId, reason); err != nil {
+		return err
+	}
+	if !approle.IsValid(role) {
+		return status.Error(codes.InvalidArgument, InvalidRole)
+	}
+	return nil
+}
+
+// validateLookUpUserRequest checks if the request has an identifier
+func validateLookUpUserRequest(request *pbuseradmin.LookUpUserRequest) error {
+	switch request.GetIdentifier().(type) {
+	case *pbuseradmin.LookUpUserRequest_UserId:
+		return validateUserId(request.GetUserId())
+	case *pbuseradmin.LookUpUserRequest_Username, *pbuseradmin.LookUpUserRequest_Email,
+		*pbuseradmin.LookUpUserRequest_PhoneNumber:
+		return nil
+	default:
+		return status.Error(codes.InvalidArgument, MissingIdentifier)
+	}
+}
@@ -0,0 +1,36 @@
+package idempotency
+
+import (
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	pbconfiguser "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/user"
+	"time"
+)
+
+const (
+	// MetadataKey is the metadata key of the idempotency key sent by the clients
+	MetadataKey = "idempotency-key"
+
+	// TTLKey is the key of the time a response is kept to be replayed
+	TTLKey = "USER_SERVICE_IDEMPOTENCY_TTL"
+
+	// TTL is the default time a response is kept to be replayed
+	TTL = 24 * time.Hour
+
+	// SecretKey is the key of the secret the request fingerprints are signed with
+	SecretKey = "USER_SERVICE_IDEMPOTENCY_SECRET"
+
+	// MinSecretLength is the minimum length of the fingerprint secret
+	MinSecretLength = 32
+
+	// MaxKeyLength is the maximum length of an idempotency key
+	MaxKeyLength = 128
+)
+
+var (
+	// Methods are the mutating methods the clients retry, which accept an idempotency key
+	Methods = map[pbtypesgrpc.Method]bool{
+		pbconfiguser.SignUp:            true,
+		pbconfiguser.AddEmail:          true,
+		pbconfiguser.ChangePhoneNumber: true,
+	}
+)
@@ -0,0 +1,8 @@
+package idempotency
+
+import "errors"
+
+var (
+	KeyExistsError   = errors.New("idempotency key already exists")
+	ShortSecretError = errors.New("must have at least 32 characters")
+)
@@ -0,0 +1,64 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+type (
+	// Record is the stored request of an idempotency key, which is not completed while the request is in progress
+	Record struct {
+		Key         string
+		Fingerprint string
+		Response    []byte
+		Completed   bool
+	}
+
+	// Store interface
+	Store interface {
+		// ReserveIdempotencyKey stores the key of a request in progress until the lock expires, and returns
+		// KeyExistsError with the existing record if the key was already used
+		ReserveIdempotencyKey(ctx context.Context, key string, fingerprint string, lockTTL time.Duration) (
+			*Record,
+			error,
+		)
+
+		// CompleteIdempotencyKey stores the response of the request, so it is replayed to the retries until it
+		// expires
+		CompleteIdempotencyKey(ctx context.Context, key string, response []byte, ttl time.Duration) error
+
+		// ReleaseIdempotencyKey removes the key of a failed request, so it can be retried
+		ReleaseIdempotencyKey(ctx context.Context, key string) error
+	}
+)
+
+// ValidateSecret checks if the fingerprint secret is long enough
+func ValidateSecret(secret string) error {
+	if len(secret) < MinSecretLength {
+		return ShortSecretError
+	}
+	return nil
+}
+
+// Fingerprint returns the HMAC of the request payload signed with the secret, so the stored fingerprints of the
+// requests with passwords can't be brute-forced without it. The payload is serialized deterministically so the same
+// payload always has the same fingerprint
+func Fingerprint(secret []byte, request proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Key returns the stored key of the request, scoped by the method and the caller so the clients can't collide
+func Key(methodName string, callerId string, key string) string {
+	return methodName + ":" + callerId + ":" + key
+}
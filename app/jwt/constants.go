@@ -1,6 +1,39 @@
 package jwt
 
+import "time"
+
 const (
 	// PublicKey is the key of the JWT public key
 	PublicKey = "JWT_PUBLIC_KEY"
+
+	// KeysDirKey is the key of the directory with a PEM file per JWT public key, named after its key ID
+	KeysDirKey = "JWT_KEYS_DIR"
+
+	// JwksFileKey is the key of the JWKS file with the JWT public keys
+	JwksFileKey = "JWT_JWKS_FILE"
+
+	// KeyOverlapKey is the key of the time a removed JWT public key is still accepted
+	KeyOverlapKey = "JWT_KEY_OVERLAP"
+
+	// KeyOverlap is the default time a removed JWT public key is still accepted
+	KeyOverlap = time.Hour
+
+	// DefaultKeyId is the key ID of the single JWT public key
+	DefaultKeyId = "default"
+
+	// KeyFileExtension is the extension of the PEM files of the keys directory
+	KeyFileExtension = ".pem"
+
+	// MountedVolumeDataDir is the symbolic link swapped when a mounted config map or secret is updated
+	MountedVolumeDataDir = "..data"
+
+	// ReloadDelay is the time to wait for the file events to settle before reloading the keys
+	ReloadDelay = 500 * time.Millisecond
+)
+
+// JSON Web Key fields of the ED25519 public keys
+const (
+	JwkKeyType = "OKP"
+	JwkCurve   = "Ed25519"
+	JwkUseSig  = "sig"
 )
@@ -0,0 +1,14 @@
+package jwt
+
+import "errors"
+
+var (
+	NilKeySetError        = errors.New("key set cannot be nil")
+	NilLoaderError        = errors.New("key loader cannot be nil")
+	NoKeysError           = errors.New("no public keys found")
+	UnknownKeyIdError     = errors.New("unknown key ID")
+	DuplicateKeyIdError   = errors.New("duplicate key ID")
+	MissingKeyIdError     = errors.New("missing key ID")
+	InvalidJwkError       = errors.New("invalid JSON web key")
+	FailedToReadJwksError = errors.New("failed to read JWKS file")
+)
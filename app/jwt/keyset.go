@@ -0,0 +1,97 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"github.com/golang-jwt/jwt/v5"
+	"sort"
+	"sync"
+	"time"
+)
+
+type (
+	// key is a public key of the key set, a retired key is accepted until the overlap window ends
+	key struct {
+		publicKey ed25519.PublicKey
+		retiredAt time.Time
+	}
+
+	// KeySet is the set of ED25519 public keys selected by their key ID
+	KeySet struct {
+		mutex   sync.RWMutex
+		keys    map[string]*key
+		overlap time.Duration
+	}
+)
+
+// NewKeySet creates a new empty key set with the given overlap window
+func NewKeySet(overlap time.Duration) *KeySet {
+	return &KeySet{keys: make(map[string]*key), overlap: overlap}
+}
+
+// isAccepted checks if the key is still accepted at the given time
+func (k *KeySet) isAccepted(key *key, now time.Time) bool {
+	return key.retiredAt.IsZero() || now.Before(key.retiredAt.Add(k.overlap))
+}
+
+// Replace sets the active keys of the key set, the removed keys are retired and accepted during the overlap window
+func (k *KeySet) Replace(publicKeys map[string]ed25519.PublicKey) (retired []string) {
+	now := time.Now()
+
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	// Retire the removed keys and drop the ones whose overlap window ended
+	for kid, key := range k.keys {
+		if _, ok := publicKeys[kid]; ok {
+			continue
+		}
+		if key.retiredAt.IsZero() {
+			key.retiredAt = now
+			retired = append(retired, kid)
+		}
+		if !k.isAccepted(key, now) {
+			delete(k.keys, kid)
+		}
+	}
+
+	// Add the active keys, a retired key that is added again becomes active
+	for kid, publicKey := range publicKeys {
+		k.keys[kid] = &key{publicKey: publicKey}
+	}
+
+	sort.Strings(retired)
+	return retired
+}
+
+// Get returns the accepted public key with the given key ID
+func (k *KeySet) Get(kid string) (ed25519.PublicKey, error) {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	key, ok := k.keys[kid]
+	if !ok || !k.isAccepted(key, time.Now()) {
+		return nil, UnknownKeyIdError
+	}
+	return key.publicKey, nil
+}
+
+// VerificationKeys returns every accepted public key, used for the tokens without a key ID
+func (k *KeySet) VerificationKeys() jwt.VerificationKeySet {
+	now := time.Now()
+
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	var keySet jwt.VerificationKeySet
+	for _, key := range k.keys {
+		if k.isAccepted(key, now) {
+			keySet.Keys = append(keySet.Keys, key.publicKey)
+		}
+	}
+	return keySet
+}
+
+// Len returns the number of accepted public keys
+func (k *KeySet) Len() int {
+	return len(k.VerificationKeys().Keys)
+}
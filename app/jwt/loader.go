@@ -0,0 +1,137 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	commonjwt "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type (
+	// Loader loads the public keys by their key ID
+	Loader func() (map[string]ed25519.PublicKey, error)
+
+	// jwk is a JSON web key of an ED25519 public key
+	jwk struct {
+		KeyType string `json:"kty"`
+		Curve   string `json:"crv"`
+		KeyId   string `json:"kid"`
+		Use     string `json:"use,omitempty"`
+		X       string `json:"x"`
+	}
+
+	// jwks is a JSON web key set
+	jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+)
+
+// ParsePublicKey parses the given PEM encoded ED25519 public key
+func ParsePublicKey(pem []byte) (ed25519.PublicKey, error) {
+	key, err := jwt.ParseEdPublicKeyFromPEM(pem)
+	if err != nil {
+		return nil, commonjwt.UnableToParsePublicKeyError
+	}
+
+	// Ensure the key is of type ED25519 public key
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, commonjwt.InvalidKeyTypeError
+	}
+	return publicKey, nil
+}
+
+// NewPublicKeyLoader creates a loader of the single given PEM encoded public key
+func NewPublicKeyLoader(pem string) Loader {
+	return func() (map[string]ed25519.PublicKey, error) {
+		publicKey, err := ParsePublicKey([]byte(pem))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]ed25519.PublicKey{DefaultKeyId: publicKey}, nil
+	}
+}
+
+// NewDirectoryLoader creates a loader of the PEM files of the given directory, the file name is the key ID
+func NewDirectoryLoader(dir string) Loader {
+	return func() (map[string]ed25519.PublicKey, error) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKeys := make(map[string]ed25519.PublicKey)
+		for _, entry := range entries {
+			// Skip the directories, the hidden files and the files without the PEM extension
+			name := entry.Name()
+			if entry.IsDir() || strings.HasPrefix(name, ".") || filepath.Ext(name) != KeyFileExtension {
+				continue
+			}
+
+			pem, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				return nil, err
+			}
+			publicKey, err := ParsePublicKey(pem)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			publicKeys[strings.TrimSuffix(name, KeyFileExtension)] = publicKey
+		}
+
+		if len(publicKeys) == 0 {
+			return nil, NoKeysError
+		}
+		return publicKeys, nil
+	}
+}
+
+// NewJwksLoader creates a loader of the ED25519 keys of the given JWKS file
+func NewJwksLoader(path string) Loader {
+	return func() (map[string]ed25519.PublicKey, error) {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Join(FailedToReadJwksError, err)
+		}
+
+		var keySet jwks
+		if err = json.Unmarshal(content, &keySet); err != nil {
+			return nil, errors.Join(FailedToReadJwksError, err)
+		}
+
+		publicKeys := make(map[string]ed25519.PublicKey)
+		for _, key := range keySet.Keys {
+			// Skip the keys that are not ED25519 signature keys
+			if key.KeyType != JwkKeyType || key.Curve != JwkCurve {
+				continue
+			}
+			if key.Use != "" && key.Use != JwkUseSig {
+				continue
+			}
+
+			if key.KeyId == "" {
+				return nil, MissingKeyIdError
+			}
+			if _, ok := publicKeys[key.KeyId]; ok {
+				return nil, fmt.Errorf("%s: %w", key.KeyId, DuplicateKeyIdError)
+			}
+
+			x, err := base64.RawURLEncoding.DecodeString(key.X)
+			if err != nil || len(x) != ed25519.PublicKeySize {
+				return nil, fmt.Errorf("%s: %w", key.KeyId, InvalidJwkError)
+			}
+			publicKeys[key.KeyId] = x
+		}
+
+		if len(publicKeys) == 0 {
+			return nil, NoKeysError
+		}
+		return publicKeys, nil
+	}
+}
@@ -0,0 +1,60 @@
+package jwt
+
+import (
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	"strconv"
+	"strings"
+)
+
+// Logger is the logger for the JWT key set
+type Logger struct {
+	logger commonlogger.Logger
+}
+
+// NewLogger creates a new JWT key set logger
+func NewLogger(logger commonlogger.Logger) (*Logger, error) {
+	// Check if the logger is nil
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &Logger{logger: logger}, nil
+}
+
+// ReloadRequested logs the reason of a key set reload
+func (l *Logger) ReloadRequested(reason string) {
+	l.logger.LogMessage(
+		commonlogger.NewLogMessage(
+			"Key set reload requested",
+			commonlogger.StatusDebug,
+			reason,
+		),
+	)
+}
+
+// KeysLoaded logs the number of loaded keys
+func (l *Logger) KeysLoaded(count int) {
+	l.logger.LogMessage(
+		commonlogger.NewLogMessage(
+			"Key set loaded",
+			commonlogger.StatusSuccess,
+			strconv.Itoa(count)+" keys",
+		),
+	)
+}
+
+// KeysRetired logs the keys removed from the source, still accepted during the overlap window
+func (l *Logger) KeysRetired(kids []string) {
+	l.logger.LogMessage(
+		commonlogger.NewLogMessage(
+			"Keys retired",
+			commonlogger.StatusInfo,
+			strings.Join(kids, ", "),
+		),
+	)
+}
+
+// FailedToLoadKeys logs the key set reload failure, the previous keys are kept
+func (l *Logger) FailedToLoadKeys(err error) {
+	l.logger.LogError(commonlogger.NewLogError("Key set reload failed", err))
+}
@@ -0,0 +1,148 @@
+package jwt
+
+import (
+	"context"
+	"github.com/fsnotify/fsnotify"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+// Reloader reloads the key set when its source changes or on SIGHUP
+type Reloader struct {
+	keySet  *KeySet
+	load    Loader
+	path    string
+	file    string
+	logger  *Logger
+	watcher *fsnotify.Watcher
+	signals chan os.Signal
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+// NewReloader creates a new reloader of the key set from the given source path, either a directory, a file or empty if it is not watched
+func NewReloader(keySet *KeySet, load Loader, path string, logger *Logger) (*Reloader, error) {
+	// Check if either the key set or the loader is nil
+	if keySet == nil {
+		return nil, NilKeySetError
+	}
+	if load == nil {
+		return nil, NilLoaderError
+	}
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &Reloader{
+		keySet:  keySet,
+		load:    load,
+		path:    path,
+		logger:  logger,
+		signals: make(chan os.Signal, 1),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}, nil
+}
+
+// Reload loads the keys and replaces the active keys of the key set, the previous keys are kept on failure
+func (r *Reloader) Reload() error {
+	publicKeys, err := r.load()
+	if err != nil {
+		r.logger.FailedToLoadKeys(err)
+		return err
+	}
+
+	if retired := r.keySet.Replace(publicKeys); len(retired) > 0 {
+		r.logger.KeysRetired(retired)
+	}
+	r.logger.KeysLoaded(len(publicKeys))
+	return nil
+}
+
+// Start watches the source and the SIGHUP signal in the background
+func (r *Reloader) Start() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	// Watch the directory of a file source, since the file is usually replaced rather than written
+	dir := r.path
+	if info, err := os.Stat(r.path); err == nil && !info.IsDir() {
+		dir = filepath.Dir(r.path)
+		r.file = filepath.Base(r.path)
+	}
+	if err = watcher.Add(dir); err != nil {
+		_ = watcher.Close()
+		return err
+	}
+	r.watcher = watcher
+
+	signal.Notify(r.signals, syscall.SIGHUP)
+	go r.watch()
+	return nil
+}
+
+// watch reloads the key set once the file events settle or when a SIGHUP is received
+func (r *Reloader) watch() {
+	defer close(r.stopped)
+
+	timer := time.NewTimer(ReloadDelay)
+	timer.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			timer.Stop()
+			return
+		case event, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+			// Skip the events of the unrelated files of the directory of a file source
+			if r.file != "" && !r.isSource(event.Name) {
+				continue
+			}
+			timer.Reset(ReloadDelay)
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+			r.logger.FailedToLoadKeys(err)
+		case sig := <-r.signals:
+			r.logger.ReloadRequested(sig.String())
+			_ = r.Reload()
+		case <-timer.C:
+			r.logger.ReloadRequested("source changed")
+			_ = r.Reload()
+		}
+	}
+}
+
+// isSource checks if the given path is the file source or the symbolic link swapped by a mounted volume
+func (r *Reloader) isSource(path string) bool {
+	name := filepath.Base(path)
+	return name == r.file || name == MountedVolumeDataDir
+}
+
+// Stop stops watching the source and the SIGHUP signal
+func (r *Reloader) Stop(ctx context.Context) error {
+	// Check if the reloader was started
+	if r.watcher == nil {
+		return nil
+	}
+
+	signal.Stop(r.signals)
+	close(r.done)
+
+	select {
+	case <-r.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return r.watcher.Close()
+}
@@ -0,0 +1,160 @@
+package jwt
+
+import (
+	"errors"
+	"github.com/golang-jwt/jwt/v5"
+	commonflag "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/config/flag"
+	commonjwt "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt"
+	commonjwtvalidator "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt/validator"
+	commonjwtvalidatorgrpc "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt/validator/grpc"
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+)
+
+// KeySetValidator handles parsing and validation of JWT tokens with the ED25519 public key selected by the kid header
+type KeySetValidator struct {
+	keySet         *KeySet
+	tokenValidator commonjwtvalidatorgrpc.TokenValidator
+	mode           *commonflag.ModeFlag
+}
+
+// NewKeySetValidator creates a new validator with the given key set
+func NewKeySetValidator(
+	keySet *KeySet, tokenValidator commonjwtvalidatorgrpc.TokenValidator, mode *commonflag.ModeFlag,
+) (*KeySetValidator, error) {
+	// Check if either the key set, the token validator or the mode flag is nil
+	if keySet == nil {
+		return nil, NilKeySetError
+	}
+	if tokenValidator == nil {
+		return nil, commonjwtvalidatorgrpc.NilTokenValidatorError
+	}
+	if mode == nil {
+		return nil, commonflag.NilModeFlagError
+	}
+
+	return &KeySetValidator{
+		keySet:         keySet,
+		tokenValidator: tokenValidator,
+		mode:           mode,
+	}, nil
+}
+
+// keyFunc returns the public key of the token kid header, or every accepted key if the token has no kid header
+func (d *KeySetValidator) keyFunc(token *jwt.Token) (interface{}, error) {
+	// Check to see if the token uses the expected signing method
+	if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+		return nil, commonjwtvalidator.UnexpectedSigningMethodError
+	}
+
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		return d.keySet.VerificationKeys(), nil
+	}
+	return d.keySet.Get(kid)
+}
+
+// GetToken parses the given JWT token string
+func (d *KeySetValidator) GetToken(tokenString string) (*jwt.Token, error) {
+	// Parse JWT and verify signature
+	token, err := jwt.Parse(tokenString, d.keyFunc)
+	if err != nil {
+		if d.mode.IsDev() {
+			return nil, err
+		}
+
+		switch {
+		case errors.Is(err, commonjwtvalidator.UnexpectedSigningMethodError):
+		case errors.Is(err, jwt.ErrSignatureInvalid):
+		case errors.Is(err, jwt.ErrTokenExpired):
+		case errors.Is(err, jwt.ErrTokenNotValidYet):
+		case errors.Is(err, jwt.ErrTokenMalformed):
+			return nil, err
+		default:
+			return nil, commonjwtvalidator.InvalidTokenError
+		}
+	}
+
+	// Check if the token is valid
+	if !token.Valid {
+		return nil, commonjwtvalidator.InvalidTokenError
+	}
+
+	return token, nil
+}
+
+// GetClaims parses and validates the given JWT token string
+func (d *KeySetValidator) GetClaims(tokenString string) (*jwt.MapClaims, error) {
+	// Get the token
+	token, err := d.GetToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get token claims
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, commonjwtvalidator.InvalidClaimsError
+	}
+
+	return &claims, nil
+}
+
+// ValidateClaims validates the given claims
+func (d *KeySetValidator) ValidateClaims(
+	token string,
+	claims *jwt.MapClaims,
+	interception pbtypesgrpc.Interception,
+) (*jwt.MapClaims, error) {
+	// Check if the claims are nil
+	if claims == nil {
+		return nil, commonjwtvalidator.NilJwtClaimsError
+	}
+
+	// Check if is a refresh token
+	irt, ok := (*claims)[commonjwt.IsRefreshTokenClaim].(bool)
+	if !ok {
+		return nil, commonjwtvalidator.IRTNotValidError
+	}
+
+	// Get the JWT Identifier
+	jwtId, ok := (*claims)[commonjwt.IdClaim].(string)
+	if !ok {
+		return nil, commonjwtvalidator.IdentifierNotValidError
+	}
+
+	// Check if it must be a refresh token
+	if !irt && interception == pbtypesgrpc.RefreshToken {
+		return nil, commonjwtvalidator.MustBeRefreshTokenError
+	}
+
+	// Check if it must be an access token
+	if irt && interception == pbtypesgrpc.AccessToken {
+		return nil, commonjwtvalidator.MustBeAccessTokenError
+	}
+
+	// Check if the token is valid
+	isValid, err := d.tokenValidator.IsTokenValid(token, jwtId, irt)
+	if err != nil {
+		return nil, err
+	}
+	if !isValid {
+		return nil, commonjwtvalidator.InvalidTokenError
+	}
+
+	return claims, nil
+}
+
+// GetValidatedClaims parses, validates and returns the claims of the given JWT token string
+func (d *KeySetValidator) GetValidatedClaims(
+	token string,
+	interception pbtypesgrpc.Interception,
+) (*jwt.MapClaims, error) {
+	// Get the claims
+	claims, err := d.GetClaims(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the claims
+	return d.ValidateClaims(token, claims, interception)
+}
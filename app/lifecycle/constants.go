@@ -0,0 +1,29 @@
+package lifecycle
+
+import "time"
+
+const (
+	// GracefulStopTimeoutKey is the key of the graceful stop timeout
+	GracefulStopTimeoutKey = "GRACEFUL_STOP_TIMEOUT"
+
+	// StepTimeoutKey is the key of the shutdown step timeout
+	StepTimeoutKey = "SHUTDOWN_STEP_TIMEOUT"
+
+	// GracefulStopTimeout is the maximum time to wait for in-flight requests to drain
+	GracefulStopTimeout = 10 * time.Second
+
+	// StepTimeout is the default timeout for each shutdown step
+	StepTimeout = 5 * time.Second
+)
+
+// Exit codes returned by the process
+const (
+	// ExitCodeSuccess is returned when the server shut down cleanly after a signal
+	ExitCodeSuccess = 0
+
+	// ExitCodeServeFailed is returned when the gRPC server stopped serving unexpectedly
+	ExitCodeServeFailed = 1
+
+	// ExitCodeShutdownFailed is returned when at least one shutdown step failed
+	ExitCodeShutdownFailed = 2
+)
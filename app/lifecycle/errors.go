@@ -0,0 +1,8 @@
+package lifecycle
+
+import "errors"
+
+var (
+	NilStopFunctionError     = errors.New("shutdown step stop function cannot be nil")
+	GracefulStopTimeoutError = errors.New("graceful stop timed out, forcing stop")
+)
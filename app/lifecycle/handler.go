@@ -0,0 +1,97 @@
+package lifecycle
+
+import (
+	"context"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+type (
+	// StopFunction releases a resource during shutdown
+	StopFunction func(ctx context.Context) error
+
+	// step is a named shutdown step
+	step struct {
+		name    string
+		timeout time.Duration
+		stop    StopFunction
+	}
+
+	// Handler runs the shutdown steps in the order they were added
+	Handler struct {
+		steps  []step
+		logger *Logger
+	}
+)
+
+// NewHandler creates a new lifecycle handler
+func NewHandler(logger *Logger) (*Handler, error) {
+	// Check if the logger is nil
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &Handler{logger: logger}, nil
+}
+
+// AddStep appends a shutdown step with the given timeout
+func (h *Handler) AddStep(
+	name string,
+	timeout time.Duration,
+	stop StopFunction,
+) error {
+	// Check if the stop function is nil
+	if stop == nil {
+		return NilStopFunctionError
+	}
+
+	h.steps = append(h.steps, step{name: name, timeout: timeout, stop: stop})
+	return nil
+}
+
+// WaitForSignal blocks until a termination signal is received or the serve function returns
+func (h *Handler) WaitForSignal(serveErr <-chan error) (exitCode int) {
+	// Listen for termination signals
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	select {
+	case sig := <-signals:
+		h.logger.SignalReceived(sig)
+		return ExitCodeSuccess
+	case err := <-serveErr:
+		h.logger.ServeFailed(err)
+		return ExitCodeServeFailed
+	}
+}
+
+// Shutdown runs every shutdown step in order and returns the process exit code
+func (h *Handler) Shutdown(exitCode int) int {
+	h.logger.ShutdownStarted()
+
+	for _, s := range h.steps {
+		h.logger.StepStarted(s.name)
+
+		// Run the step with its own deadline
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		err := s.stop(ctx)
+		cancel()
+
+		// A failed step does not prevent the next ones from running
+		if err != nil {
+			h.logger.FailedStep(s.name, err)
+			if exitCode == ExitCodeSuccess {
+				exitCode = ExitCodeShutdownFailed
+			}
+			continue
+		}
+		h.logger.StepCompleted(s.name)
+	}
+
+	h.logger.ShutdownCompleted(exitCode)
+	return exitCode
+}
@@ -0,0 +1,91 @@
+package lifecycle
+
+import (
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	"os"
+	"strconv"
+)
+
+// Logger is the logger for the application lifecycle
+type Logger struct {
+	logger commonlogger.Logger
+}
+
+// NewLogger creates a new lifecycle logger
+func NewLogger(logger commonlogger.Logger) (*Logger, error) {
+	// Check if the logger is nil
+	if logger == nil {
+		return nil, commonlogger.NilLoggerError
+	}
+
+	return &Logger{logger: logger}, nil
+}
+
+// SignalReceived logs the received shutdown signal
+func (l *Logger) SignalReceived(signal os.Signal) {
+	l.logger.LogMessage(
+		commonlogger.NewLogMessage(
+			"Shutdown signal received",
+			commonlogger.StatusInfo,
+			signal.String(),
+		),
+	)
+}
+
+// ServeFailed logs that the server stopped serving unexpectedly
+func (l *Logger) ServeFailed(err error) {
+	l.logger.LogError(commonlogger.NewLogError("Server stopped serving", err))
+}
+
+// ShutdownStarted logs the start of the shutdown sequence
+func (l *Logger) ShutdownStarted() {
+	l.logger.LogMessage(
+		commonlogger.NewLogMessage(
+			"Shutdown started",
+			commonlogger.StatusInfo,
+		),
+	)
+}
+
+// StepStarted logs the start of a shutdown step
+func (l *Logger) StepStarted(name string) {
+	l.logger.LogMessage(
+		commonlogger.NewLogMessage(
+			"Shutdown step started",
+			commonlogger.StatusDebug,
+			name,
+		),
+	)
+}
+
+// StepCompleted logs the completion of a shutdown step
+func (l *Logger) StepCompleted(name string) {
+	l.logger.LogMessage(
+		commonlogger.NewLogMessage(
+			"Shutdown step completed",
+			commonlogger.StatusSuccess,
+			name,
+		),
+	)
+}
+
+// FailedStep logs the failure of a shutdown step
+func (l *Logger) FailedStep(name string, err error) {
+	l.logger.LogError(
+		commonlogger.NewLogError(
+			"Shutdown step failed: "+name,
+			err,
+		),
+	)
+}
+
+// ShutdownCompleted logs the end of the shutdown sequence
+func (l *Logger) ShutdownCompleted(exitCode int) {
+	l.logger.LogMessage(
+		commonlogger.NewLogMessage(
+			"Shutdown completed",
+			commonlogger.StatusInfo,
+			"exit code "+strconv.Itoa(exitCode),
+		),
+	)
+}
@@ -0,0 +1,75 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"github.com/go-redis/redis/v8"
+	commondatabase "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database"
+	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+)
+
+// SetNotServing marks every service of the health server as NOT_SERVING
+func SetNotServing(healthServer *health.Server) StopFunction {
+	return func(ctx context.Context) error {
+		healthServer.Shutdown()
+		return nil
+	}
+}
+
+// GracefulStop stops the gRPC server after the in-flight requests finish, forcing the stop once the deadline is exceeded
+func GracefulStop(server *grpc.Server) StopFunction {
+	return func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			server.Stop()
+			return GracefulStopTimeoutError
+		}
+	}
+}
+
+// CloseClientConnections closes the given gRPC client connections
+func CloseClientConnections(conns map[string]*grpc.ClientConn) StopFunction {
+	return func(ctx context.Context) error {
+		var errs []error
+		for _, conn := range conns {
+			if err := conn.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// DisconnectMongoDB disconnects the MongoDB client of the given connection handler
+func DisconnectMongoDB(
+	connection *commonmongodb.DefaultConnectionHandler,
+	logger *commondatabase.Logger,
+) StopFunction {
+	return func(ctx context.Context) error {
+		// Release the connection context once disconnected
+		defer connection.Cancel()
+
+		if err := connection.Client.Disconnect(ctx); err != nil {
+			return err
+		}
+		logger.DisconnectedFromDatabase()
+		return nil
+	}
+}
+
+// CloseRedis closes the given Redis client
+func CloseRedis(client *redis.Client) StopFunction {
+	return func(ctx context.Context) error {
+		return client.Close()
+	}
+}
@@ -0,0 +1,14 @@
+package listener
+
+import (
+	commonlistener "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/listener"
+	"net"
+)
+
+// NewServicePort creates the service port of the given host and port
+func NewServicePort(host string, port string) *commonlistener.ServicePort {
+	return &commonlistener.ServicePort{
+		Port:          port,
+		FormattedPort: net.JoinHostPort(host, port),
+	}
+}
@@ -6,26 +6,56 @@ import (
 	commonjwtvalidator "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt/validator"
 	commondatabase "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database"
 	commonlistener "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/listener"
-	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
 	userserver "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/user"
+	useradminserver "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/useradmin"
+	appjwt "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/jwt"
+	applifecycle "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/lifecycle"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
 )
 
 var (
 	// Flag is the logger for the flag
-	Flag, _ = commonflag.NewLogger(commonlogger.NewDefaultLogger("Flag"))
+	Flag, _ = commonflag.NewLogger(appstructuredlogger.NewCommonLogger("Flag"))
 
 	// Listener is the logger for the listener
-	Listener, _ = commonlistener.NewLogger(commonlogger.NewDefaultLogger("Net Listener"))
+	Listener, _ = commonlistener.NewLogger(appstructuredlogger.NewCommonLogger("Net Listener"))
 
 	// Environment is the logger for the environment
-	Environment, _ = commonenv.NewLogger(commonlogger.NewDefaultLogger("Environment"))
+	Environment, _ = commonenv.NewLogger(appstructuredlogger.NewCommonLogger("Environment"))
 
 	// MongoDb is the logger for the MongoDB client
-	MongoDb, _ = commondatabase.NewLogger(commonlogger.NewDefaultLogger("MongoDB"))
+	MongoDb, _ = commondatabase.NewLogger(appstructuredlogger.NewCommonLogger("MongoDB"))
 
 	// UserServer is the logger for the user server
-	UserServer, _ = userserver.NewLogger(commonlogger.NewDefaultLogger("User Server"))
+	UserServer, _ = userserver.NewLogger(appstructuredlogger.NewLogger("User Server"))
+
+	// UserAdminServer is the logger for the user admin server
+	UserAdminServer, _ = useradminserver.NewLogger(appstructuredlogger.NewLogger("User Admin Server"))
 
 	// JwtValidator is the logger for the JWT validator
-	JwtValidator, _ = commonjwtvalidator.NewLogger(commonlogger.NewDefaultLogger("JWT Validator"))
+	JwtValidator, _ = commonjwtvalidator.NewLogger(appstructuredlogger.NewCommonLogger("JWT Validator"))
+
+	// JwtKeySet is the logger for the JWT key set
+	JwtKeySet, _ = appjwt.NewLogger(appstructuredlogger.NewCommonLogger("JWT Key Set"))
+
+	// Lifecycle is the logger for the application lifecycle
+	Lifecycle, _ = applifecycle.NewLogger(appstructuredlogger.NewCommonLogger("Lifecycle"))
+
+	// AccessLog is the logger for the gRPC server access log
+	AccessLog = appstructuredlogger.NewLogger("Access Log")
+
+	// RateLimiter is the logger for the gRPC server rate limiter
+	RateLimiter = appstructuredlogger.NewLogger("Rate Limiter")
+
+	// Authorization is the logger for the gRPC server authorization
+	Authorization = appstructuredlogger.NewLogger("Authorization")
+
+	// Idempotency is the logger for the gRPC server idempotency keys
+	Idempotency = appstructuredlogger.NewLogger("Idempotency")
+
+	// Cache is the logger for the lookups cache
+	Cache = appstructuredlogger.NewLogger("Cache")
+
+	// Migration is the logger for the data migrations
+	Migration = appstructuredlogger.NewLogger("Migration")
 )
@@ -0,0 +1,69 @@
+package structured
+
+import (
+	"log/slog"
+	"time"
+)
+
+// Error returns the attribute of the given error
+func Error(err error) slog.Attr {
+	if err == nil {
+		return slog.String(ErrorKey, "")
+	}
+	return slog.String(ErrorKey, err.Error())
+}
+
+// Method returns the attribute of the given gRPC method
+func Method(method string) slog.Attr {
+	return slog.String(MethodKey, method)
+}
+
+// RequestId returns the attribute of the given request ID
+func RequestId(requestId string) slog.Attr {
+	return slog.String(RequestIdKey, requestId)
+}
+
+// UserId returns the attribute of the given user ID
+func UserId(userId string) slog.Attr {
+	return slog.String(UserIdKey, userId)
+}
+
+// Peer returns the attribute of the given peer address
+func Peer(address string) slog.Attr {
+	return slog.String(PeerKey, address)
+}
+
+// Code returns the attribute of the given gRPC status code
+func Code(code string) slog.Attr {
+	return slog.String(CodeKey, code)
+}
+
+// Duration returns the attribute of the given duration
+func Duration(duration time.Duration) slog.Attr {
+	return slog.Duration(DurationKey, duration)
+}
+
+// Username returns the attribute of the given username
+func Username(username string) slog.Attr {
+	return slog.String(UsernameKey, username)
+}
+
+// Email returns the attribute of the given email
+func Email(email string) slog.Attr {
+	return slog.String(EmailKey, email)
+}
+
+// PhoneNumber returns the attribute of the given phone number
+func PhoneNumber(phoneNumber string) slog.Attr {
+	return slog.String(PhoneKey, phoneNumber)
+}
+
+// FirstName returns the attribute of the given first name
+func FirstName(firstName string) slog.Attr {
+	return slog.String(FirstNameKey, firstName)
+}
+
+// LastName returns the attribute of the given last name
+func LastName(lastName string) slog.Attr {
+	return slog.String(LastNameKey, lastName)
+}
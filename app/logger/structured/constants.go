@@ -0,0 +1,63 @@
+package structured
+
+import (
+	commonflag "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/config/flag"
+	"log/slog"
+)
+
+const (
+	// LevelKey is the key of the log level, it overrides the default level of the mode
+	LevelKey = "LOG_LEVEL"
+
+	// RedactEmailKey is the key of the email mask, it overrides the default mask of the mode
+	RedactEmailKey = "LOG_REDACT_EMAIL"
+
+	// RedactPhoneKey is the key of the phone number mask, it overrides the default mask of the mode
+	RedactPhoneKey = "LOG_REDACT_PHONE"
+
+	// RedactNameKey is the key of the name mask, it overrides the default mask of the mode
+	RedactNameKey = "LOG_REDACT_NAME"
+
+	// Redacted replaces the fully masked values
+	Redacted = "[REDACTED]"
+)
+
+// Attribute keys shared by every log line
+const (
+	LoggerKey    = "logger"
+	StatusKey    = "status"
+	DetailsKey   = "details"
+	ErrorKey     = "error"
+	MethodKey    = "method"
+	RequestIdKey = "request_id"
+	UserIdKey    = "user_id"
+	PeerKey      = "peer"
+	CodeKey      = "code"
+	DurationKey  = "duration"
+	UsernameKey  = "username"
+	EmailKey     = "email"
+	PhoneKey     = "phone_number"
+	FirstNameKey = "first_name"
+	LastNameKey  = "last_name"
+)
+
+var (
+	// ModeLevels are the default log levels of each mode
+	ModeLevels = map[string]slog.Level{
+		commonflag.ModeDev:  slog.LevelDebug,
+		commonflag.ModeProd: slog.LevelInfo,
+	}
+
+	// ModeRedactions are the default redaction policies of each mode
+	ModeRedactions = map[string]Redaction{
+		commonflag.ModeDev:  {Email: MaskNone, Phone: MaskNone, Name: MaskNone},
+		commonflag.ModeProd: {Email: MaskPartial, Phone: MaskPartial, Name: MaskFull},
+	}
+
+	// Masks are the masks by their names
+	Masks = map[string]Mask{
+		"none":    MaskNone,
+		"partial": MaskPartial,
+		"full":    MaskFull,
+	}
+)
@@ -0,0 +1,50 @@
+package structured
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// ctxKey is the context key of the request-scoped attributes
+type ctxKey struct{}
+
+// fields are the request-scoped attributes, shared by the interceptors and the handlers of an RPC
+type fields struct {
+	mutex sync.Mutex
+	attrs []slog.Attr
+}
+
+// NewContext returns a context that carries the given request-scoped attributes
+func NewContext(ctx context.Context, attrs ...slog.Attr) context.Context {
+	return context.WithValue(ctx, ctxKey{}, &fields{attrs: attrs})
+}
+
+// AddAttrs adds attributes to the request-scoped attributes of the context. They are also seen by the
+// interceptors that created the context, so it has no effect if the context was not created with NewContext
+func AddAttrs(ctx context.Context, attrs ...slog.Attr) {
+	f, ok := ctx.Value(ctxKey{}).(*fields)
+	if !ok {
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.attrs = append(f.attrs, attrs...)
+}
+
+// Attrs returns a copy of the request-scoped attributes of the context
+func Attrs(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+
+	f, ok := ctx.Value(ctxKey{}).(*fields)
+	if !ok {
+		return nil
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]slog.Attr(nil), f.attrs...)
+}
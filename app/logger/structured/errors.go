@@ -0,0 +1,8 @@
+package structured
+
+import "errors"
+
+var (
+	InvalidLevelError = errors.New("invalid log level")
+	InvalidMaskError  = errors.New("invalid redaction mask")
+)
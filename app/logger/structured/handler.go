@@ -0,0 +1,58 @@
+package structured
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Handler is the slog handler that adds the request-scoped attributes of the context to each record
+type Handler struct {
+	handler slog.Handler
+}
+
+// NewHandler creates a new handler on top of the given one
+func NewHandler(handler slog.Handler) *Handler {
+	return &Handler{handler: handler}
+}
+
+// Enabled reports whether the wrapped handler handles records at the given level
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle adds the request-scoped attributes to the record and passes it to the wrapped handler. The
+// attributes already set on the record take precedence
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	attrs := Attrs(ctx)
+	if len(attrs) == 0 {
+		return h.handler.Handle(ctx, record)
+	}
+
+	// Get the keys of the record attributes
+	keys := make(map[string]struct{}, record.NumAttrs())
+	record.Attrs(
+		func(attr slog.Attr) bool {
+			keys[attr.Key] = struct{}{}
+			return true
+		},
+	)
+
+	// Add the request-scoped attributes that are not set on the record
+	record = record.Clone()
+	for _, attr := range attrs {
+		if _, ok := keys[attr.Key]; !ok {
+			record.AddAttrs(attr)
+		}
+	}
+	return h.handler.Handle(ctx, record)
+}
+
+// WithAttrs returns a new handler whose wrapped handler has the given attributes
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return NewHandler(h.handler.WithAttrs(attrs))
+}
+
+// WithGroup returns a new handler whose wrapped handler has the given group
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return NewHandler(h.handler.WithGroup(name))
+}
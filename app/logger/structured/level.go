@@ -0,0 +1,41 @@
+package structured
+
+import (
+	commonflag "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/config/flag"
+	"log/slog"
+)
+
+var (
+	// Level is the minimum level of the records written by the loggers
+	Level = new(slog.LevelVar)
+)
+
+// ParseLevel parses the name of a log level
+func ParseLevel(value string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(value)); err != nil {
+		return level, InvalidLevelError
+	}
+	return level, nil
+}
+
+// SetLevel sets the log level of the mode, overridden by the given level if it is not empty
+func SetLevel(mode *commonflag.ModeFlag, level string) error {
+	// Check if the level is overridden
+	if level != "" {
+		parsedLevel, err := ParseLevel(level)
+		if err != nil {
+			return err
+		}
+		Level.Set(parsedLevel)
+		return nil
+	}
+
+	// Set the default level of the mode
+	if mode != nil {
+		if modeLevel, ok := ModeLevels[mode.String()]; ok {
+			Level.Set(modeLevel)
+		}
+	}
+	return nil
+}
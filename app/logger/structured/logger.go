@@ -0,0 +1,90 @@
+package structured
+
+import (
+	"context"
+	commonlogger "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils/logger"
+	"log/slog"
+	"os"
+)
+
+var (
+	// Default is the JSON logger every other logger is derived from, its records are redacted
+	Default = slog.New(
+		NewHandler(
+			slog.NewJSONHandler(
+				os.Stdout,
+				&slog.HandlerOptions{
+					Level:       Level,
+					ReplaceAttr: ReplaceAttr,
+				},
+			),
+		),
+	)
+)
+
+// NewLogger creates a new structured logger with the given name
+func NewLogger(name string) *slog.Logger {
+	return Default.With(slog.String(LoggerKey, name))
+}
+
+// Logger is the adapter that writes the common log messages and errors as structured records
+type Logger struct {
+	logger *slog.Logger
+}
+
+// NewCommonLogger creates a new common logger with the given name on top of the structured logger
+func NewCommonLogger(name string) *Logger {
+	return &Logger{logger: NewLogger(name)}
+}
+
+// StatusLevel returns the log level of the given status
+func StatusLevel(status commonlogger.Status) slog.Level {
+	switch status {
+	case commonlogger.StatusFailed, commonlogger.StatusWarning:
+		return slog.LevelWarn
+	case commonlogger.StatusError:
+		return slog.LevelError
+	case commonlogger.StatusDebug, commonlogger.StatusTrace:
+		return slog.LevelDebug
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// LogMessage logs a message
+func (l *Logger) LogMessage(logMessage *commonlogger.LogMessage) {
+	if logMessage == nil {
+		return
+	}
+
+	attrs := []slog.Attr{slog.String(StatusKey, logMessage.Status.String())}
+	if len(logMessage.Details) > 0 {
+		attrs = append(attrs, slog.Any(DetailsKey, logMessage.Details))
+	}
+
+	l.logger.LogAttrs(
+		context.Background(),
+		StatusLevel(logMessage.Status),
+		logMessage.Title,
+		attrs...,
+	)
+}
+
+// LogError logs an error
+func (l *Logger) LogError(logError *commonlogger.LogError) {
+	if logError == nil {
+		return
+	}
+
+	attrs := make([]slog.Attr, 0, len(logError.Errors))
+	for _, err := range logError.Errors {
+		attrs = append(attrs, Error(err))
+	}
+
+	l.logger.LogAttrs(
+		context.Background(),
+		slog.LevelError,
+		logError.Title,
+		attrs...,
+	)
+}
@@ -0,0 +1,192 @@
+package structured
+
+import (
+	commonflag "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/config/flag"
+	"log/slog"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// Mask is how the values of a PII data class are masked
+type Mask int
+
+const (
+	MaskNone Mask = iota
+	MaskPartial
+	MaskFull
+)
+
+// Redaction is the masking policy of each PII data class
+type Redaction struct {
+	Email Mask
+	Phone Mask
+	Name  Mask
+}
+
+var (
+	// redaction is the current policy, every class is masked until the mode policy is set
+	redaction atomic.Pointer[Redaction]
+
+	// emailPattern matches the emails inside free text
+	emailPattern = regexp.MustCompile(`[\w.+\-]+@[\w\-]+(?:\.[\w\-]+)+`)
+
+	// phonePattern matches the phone numbers inside free text
+	phonePattern = regexp.MustCompile(`\+?\b\d[\d\s\-()]{5,}\d\b`)
+)
+
+func init() {
+	redaction.Store(&Redaction{Email: MaskFull, Phone: MaskFull, Name: MaskFull})
+}
+
+// ParseMask parses the name of a mask
+func ParseMask(value string) (Mask, error) {
+	mask, ok := Masks[strings.ToLower(value)]
+	if !ok {
+		return MaskNone, InvalidMaskError
+	}
+	return mask, nil
+}
+
+// SetRedaction sets the redaction policy of the mode, each mask is overridden by the given one if it is not
+// empty
+func SetRedaction(mode *commonflag.ModeFlag, email string, phone string, name string) error {
+	policy := *redaction.Load()
+
+	// Set the default policy of the mode
+	if mode != nil {
+		if modePolicy, ok := ModeRedactions[mode.String()]; ok {
+			policy = modePolicy
+		}
+	}
+
+	// Check if any mask is overridden
+	for _, override := range []struct {
+		value string
+		mask  *Mask
+	}{
+		{email, &policy.Email},
+		{phone, &policy.Phone},
+		{name, &policy.Name},
+	} {
+		if override.value == "" {
+			continue
+		}
+
+		mask, err := ParseMask(override.value)
+		if err != nil {
+			return err
+		}
+		*override.mask = mask
+	}
+
+	redaction.Store(&policy)
+	return nil
+}
+
+// MaskEmail masks the email, keeping the first character of the local part and the domain if it is partial
+func MaskEmail(email string, mask Mask) string {
+	switch mask {
+	case MaskNone:
+		return email
+	case MaskPartial:
+		at := strings.LastIndex(email, "@")
+		if at < 1 {
+			return Redacted
+		}
+		return email[:1] + "***" + email[at:]
+	default:
+		return Redacted
+	}
+}
+
+// MaskPhone masks the phone number, keeping its last 4 digits if it is partial
+func MaskPhone(phoneNumber string, mask Mask) string {
+	switch mask {
+	case MaskNone:
+		return phoneNumber
+	case MaskPartial:
+		digits := []rune(phoneNumber)
+		kept := 0
+		for i := len(digits) - 1; i >= 0; i-- {
+			if digits[i] < '0' || digits[i] > '9' {
+				continue
+			}
+			if kept < 4 {
+				kept++
+				continue
+			}
+			digits[i] = '*'
+		}
+		return string(digits)
+	default:
+		return Redacted
+	}
+}
+
+// MaskName masks the name, keeping its first character if it is partial
+func MaskName(name string, mask Mask) string {
+	switch mask {
+	case MaskNone:
+		return name
+	case MaskPartial:
+		runes := []rune(name)
+		if len(runes) == 0 {
+			return name
+		}
+		return string(runes[:1]) + "***"
+	default:
+		return Redacted
+	}
+}
+
+// MaskText masks the emails and phone numbers found inside free text
+func MaskText(text string, policy *Redaction) string {
+	if policy.Email != MaskNone {
+		text = emailPattern.ReplaceAllStringFunc(
+			text,
+			func(email string) string {
+				return MaskEmail(email, policy.Email)
+			},
+		)
+	}
+	if policy.Phone != MaskNone {
+		text = phonePattern.ReplaceAllStringFunc(
+			text,
+			func(phoneNumber string) string {
+				return MaskPhone(phoneNumber, policy.Phone)
+			},
+		)
+	}
+	return text
+}
+
+// ReplaceAttr masks the PII of the attribute with the current redaction policy. The attributes of a known
+// data class are masked by their key, while the free text ones are scanned for emails and phone numbers
+func ReplaceAttr(groups []string, attr slog.Attr) slog.Attr {
+	policy := redaction.Load()
+
+	switch attr.Key {
+	case EmailKey:
+		return slog.String(attr.Key, MaskEmail(attr.Value.String(), policy.Email))
+	case PhoneKey:
+		return slog.String(attr.Key, MaskPhone(attr.Value.String(), policy.Phone))
+	case FirstNameKey, LastNameKey:
+		return slog.String(attr.Key, MaskName(attr.Value.String(), policy.Name))
+	}
+
+	// Scan the free text attributes
+	switch attr.Value.Kind() {
+	case slog.KindString:
+		return slog.String(attr.Key, MaskText(attr.Value.String(), policy))
+	case slog.KindAny:
+		if values, ok := attr.Value.Any().([]string); ok {
+			masked := make([]string, len(values))
+			for i, value := range values {
+				masked[i] = MaskText(value, policy)
+			}
+			return slog.Any(attr.Key, masked)
+		}
+	}
+	return attr
+}
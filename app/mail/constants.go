@@ -0,0 +1,59 @@
+package mail
+
+import "time"
+
+const (
+	// HostKey is the key of the SMTP server host, the emails are disabled if it is not set
+	HostKey = "USER_SERVICE_SMTP_HOST"
+
+	// PortKey is the key of the SMTP server port
+	PortKey = "USER_SERVICE_SMTP_PORT"
+
+	// UsernameKey is the key of the SMTP server username
+	UsernameKey = "USER_SERVICE_SMTP_USERNAME"
+
+	// PasswordKey is the key of the SMTP server password
+	PasswordKey = "USER_SERVICE_SMTP_PASSWORD"
+
+	// FromKey is the key of the sender address of the emails
+	FromKey = "USER_SERVICE_SMTP_FROM"
+
+	// ResetPasswordUrlKey is the key of the URL of the page where the users reset their password
+	ResetPasswordUrlKey = "USER_SERVICE_RESET_PASSWORD_URL"
+
+	// ResetPasswordTTLKey is the key of the time a password reset link can be used
+	ResetPasswordTTLKey = "USER_SERVICE_RESET_PASSWORD_TTL"
+
+	// VerifyEmailUrlKey is the key of the URL of the page where the users verify their emails
+	VerifyEmailUrlKey = "USER_SERVICE_VERIFY_EMAIL_URL"
+
+	// VerifyEmailTTLKey is the key of the time an email verification link can be used
+	VerifyEmailTTLKey = "USER_SERVICE_VERIFY_EMAIL_TTL"
+
+	// Port is the default SMTP server port
+	Port = "587"
+
+	// ResetPasswordTTL is the default time a password reset link can be used
+	ResetPasswordTTL = time.Hour
+
+	// VerifyEmailTTL is the default time an email verification link can be used
+	VerifyEmailTTL = 24 * time.Hour
+
+	// TokenQueryParameter is the query parameter of the links that carries the token
+	TokenQueryParameter = "token"
+
+	// TokenLength is the number of random bytes of a token
+	TokenLength = 32
+)
+
+// Emails
+const (
+	ResetPasswordSubject = "Reset your Pixel Plaza password"
+	ResetPasswordBody    = "We received a request to reset the password of your Pixel Plaza account.\r\n\r\n" +
+		"Open the following link to choose a new password, it expires in %s:\r\n\r\n%s\r\n\r\n" +
+		"If you didn't request it, you can ignore this email."
+	VerifyEmailSubject = "Verify your Pixel Plaza email"
+	VerifyEmailBody    = "This email was added to a Pixel Plaza account.\r\n\r\n" +
+		"Open the following link to verify it, it expires in %s:\r\n\r\n%s\r\n\r\n" +
+		"If you didn't add it, you can ignore this email."
+)
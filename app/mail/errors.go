@@ -0,0 +1,10 @@
+package mail
+
+import "errors"
+
+var (
+	NilSenderError        = errors.New("mail sender cannot be nil")
+	InvalidHeaderError    = errors.New("mail header cannot contain line breaks")
+	InvalidLinkUrlError   = errors.New("link URL must be an absolute URL")
+	StartTLSRequiredError = errors.New("SMTP server must support STARTTLS")
+)
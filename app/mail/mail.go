@@ -0,0 +1,96 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Sender interface
+type Sender interface {
+	Send(ctx context.Context, to string, subject string, body string) error
+}
+
+// Mailer sends the emails of the user service with the links to its pages
+type Mailer struct {
+	sender           Sender
+	resetPasswordUrl *url.URL
+	verifyEmailUrl   *url.URL
+}
+
+// NewMailer creates a new mailer
+func NewMailer(sender Sender, resetPasswordUrl string, verifyEmailUrl string) (*Mailer, error) {
+	// Check if the sender is nil
+	if sender == nil {
+		return nil, NilSenderError
+	}
+
+	// Parse the URLs of the links
+	parsedResetPasswordUrl, err := ParseLinkUrl(resetPasswordUrl)
+	if err != nil {
+		return nil, err
+	}
+	parsedVerifyEmailUrl, err := ParseLinkUrl(verifyEmailUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mailer{
+		sender:           sender,
+		resetPasswordUrl: parsedResetPasswordUrl,
+		verifyEmailUrl:   parsedVerifyEmailUrl,
+	}, nil
+}
+
+// ParseLinkUrl parses the URL of the page a link opens
+func ParseLinkUrl(value string) (*url.URL, error) {
+	parsedUrl, err := url.Parse(value)
+	if err != nil || !parsedUrl.IsAbs() || parsedUrl.Host == "" {
+		return nil, InvalidLinkUrlError
+	}
+	return parsedUrl, nil
+}
+
+// link returns the link to the page with the token
+func link(pageUrl *url.URL, token string) string {
+	linkUrl := *pageUrl
+	query := linkUrl.Query()
+	query.Set(TokenQueryParameter, token)
+	linkUrl.RawQuery = query.Encode()
+	return linkUrl.String()
+}
+
+// formatTTL formats the time a link can be used in whole hours or minutes
+func formatTTL(ttl time.Duration) string {
+	if ttl >= time.Hour && ttl%time.Hour == 0 {
+		if hours := int(ttl / time.Hour); hours != 1 {
+			return fmt.Sprintf("%d hours", hours)
+		}
+		return "1 hour"
+	}
+	if minutes := int(ttl / time.Minute); minutes != 1 {
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+	return "1 minute"
+}
+
+// SendResetPassword sends the password reset link to the email
+func (m *Mailer) SendResetPassword(ctx context.Context, email string, token string, ttl time.Duration) error {
+	return m.sender.Send(
+		ctx,
+		email,
+		ResetPasswordSubject,
+		fmt.Sprintf(ResetPasswordBody, formatTTL(ttl), link(m.resetPasswordUrl, token)),
+	)
+}
+
+// SendEmailVerification sends the email verification link to the email
+func (m *Mailer) SendEmailVerification(ctx context.Context, email string, token string, ttl time.Duration) error {
+	return m.sender.Send(
+		ctx,
+		email,
+		VerifyEmailSubject,
+		fmt.Sprintf(VerifyEmailBody, formatTTL(ttl), link(m.verifyEmailUrl, token)),
+	)
+}
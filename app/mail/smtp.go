@@ -0,0 +1,104 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPSender is the sender of the emails through an SMTP server
+type SMTPSender struct {
+	host     string
+	address  string
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPSender creates a new SMTP sender, the server is authenticated only if the username is set
+func NewSMTPSender(host string, port string, username string, password string, from string) *SMTPSender {
+	return &SMTPSender{
+		host:     host,
+		address:  net.JoinHostPort(host, port),
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Send sends the plain text email through the SMTP server, the email is not sent if the connection can't be
+// upgraded to TLS, since it has password reset and verification links
+func (s *SMTPSender) Send(ctx context.Context, to string, subject string, body string) error {
+	// Check if the headers would inject other headers
+	if strings.ContainsAny(to, "\r\n") || strings.ContainsAny(subject, "\r\n") {
+		return InvalidHeaderError
+	}
+
+	// Connect to the server
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	// Upgrade the connection and authenticate
+	if ok, _ := client.Extension("STARTTLS"); !ok {
+		return StartTLSRequiredError
+	}
+	if err = client.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); err != nil {
+		return err
+	}
+	if s.username != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	// Send the email
+	if err = client.Mail(s.from); err != nil {
+		return err
+	}
+	if err = client.Rcpt(to); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = writer.Write(message(s.from, to, subject, body)); err != nil {
+		writer.Close()
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// message builds the plain text email with its headers
+func message(from string, to string, subject string, body string) []byte {
+	var builder strings.Builder
+	builder.WriteString("From: " + from + "\r\n")
+	builder.WriteString("To: " + to + "\r\n")
+	builder.WriteString("Subject: " + subject + "\r\n")
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	builder.WriteString("\r\n")
+	builder.WriteString(body)
+	builder.WriteString("\r\n")
+	return []byte(builder.String())
+}
@@ -0,0 +1,23 @@
+package mail
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// NewToken generates a random token to be sent by email
+func NewToken() (string, error) {
+	token := make([]byte, TokenLength)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// HashToken hashes the token, only the hashes are stored so the tokens can't be used from a copy of the database
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,12 @@
+package metrics
+
+const (
+	// PortKey is the key of the port for the metrics HTTP server
+	PortKey = "METRICS_PORT"
+
+	// Path is the HTTP path where the metrics are exposed
+	Path = "/metrics"
+
+	// Namespace is the namespace of every metric of the service
+	Namespace = "user_service"
+)
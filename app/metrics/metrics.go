@@ -0,0 +1,192 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// Registry is the registry for every metric of the service
+	Registry = prometheus.NewRegistry()
+
+	// factory registers the collectors with the service registry
+	factory = promauto.With(Registry)
+)
+
+// gRPC server metrics
+var (
+	// GRPCServerRequestsTotal counts the handled RPCs by method and status code
+	GRPCServerRequestsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "grpc_server",
+			Name:      "requests_total",
+			Help:      "Total number of RPCs handled by the server",
+		},
+		[]string{"method", "code"},
+	)
+
+	// GRPCServerRequestDuration observes the RPCs latency by method and status code
+	GRPCServerRequestDuration = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: "grpc_server",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the RPCs handled by the server",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "code"},
+	)
+)
+
+// gRPC client metrics
+var (
+	// GRPCClientRequestsTotal counts the outgoing RPCs by method and status code
+	GRPCClientRequestsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "grpc_client",
+			Name:      "requests_total",
+			Help:      "Total number of RPCs sent to other services",
+		},
+		[]string{"method", "code"},
+	)
+
+	// GRPCClientRequestDuration observes the outgoing RPCs latency by method and status code
+	GRPCClientRequestDuration = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: "grpc_client",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the RPCs sent to other services",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "code"},
+	)
+)
+
+// MongoDB metrics
+var (
+	// MongoDBCommandsTotal counts the MongoDB commands by collection, command and result
+	MongoDBCommandsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "mongodb",
+			Name:      "commands_total",
+			Help:      "Total number of MongoDB commands",
+		},
+		[]string{"collection", "command", "result"},
+	)
+
+	// MongoDBCommandDuration observes the MongoDB commands latency by collection and command
+	MongoDBCommandDuration = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: "mongodb",
+			Name:      "command_duration_seconds",
+			Help:      "Latency of the MongoDB commands",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"collection", "command"},
+	)
+
+	// MongoDBPoolConnections tracks the MongoDB pool connections by state
+	MongoDBPoolConnections = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: "mongodb",
+			Name:      "pool_connections",
+			Help:      "Number of MongoDB pool connections by state",
+		},
+		[]string{"state"},
+	)
+
+	// MongoDBPoolEventsTotal counts the MongoDB pool events by type
+	MongoDBPoolEventsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "mongodb",
+			Name:      "pool_events_total",
+			Help:      "Total number of MongoDB pool events",
+		},
+		[]string{"event"},
+	)
+)
+
+// Cache metrics
+var (
+	// CacheHitsTotal counts the cache hits by lookup and tier
+	CacheHitsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "cache",
+			Name:      "hits_total",
+			Help:      "Total number of cache hits",
+		},
+		[]string{"lookup", "tier"},
+	)
+
+	// CacheMissesTotal counts the lookups that missed every cache tier
+	CacheMissesTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "cache",
+			Name:      "misses_total",
+			Help:      "Total number of cache misses",
+		},
+		[]string{"lookup"},
+	)
+
+	// CacheErrorsTotal counts the cache failures by tier and operation
+	CacheErrorsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "cache",
+			Name:      "errors_total",
+			Help:      "Total number of cache failures",
+		},
+		[]string{"tier", "operation"},
+	)
+)
+
+// Business metrics
+var (
+	// SignUpsTotal counts the users that signed up
+	SignUpsTotal = factory.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "users",
+			Name:      "sign_ups_total",
+			Help:      "Total number of users that signed up",
+		},
+	)
+
+	// PasswordChangesTotal counts the users that changed their password
+	PasswordChangesTotal = factory.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "users",
+			Name:      "password_changes_total",
+			Help:      "Total number of password changes",
+		},
+	)
+
+	// DeletionsTotal counts the users that deleted their account
+	DeletionsTotal = factory.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "users",
+			Name:      "deletions_total",
+			Help:      "Total number of deleted users",
+		},
+	)
+)
+
+func init() {
+	// Register the runtime and process collectors
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"time"
+)
+
+// NewServer creates the HTTP server that exposes the metrics on the given address
+func NewServer(address string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(
+		Path,
+		promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry}),
+	)
+
+	return &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
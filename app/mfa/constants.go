@@ -0,0 +1,28 @@
+package mfa
+
+import (
+	"time"
+)
+
+const (
+	// Issuer is the issuer shown by the authenticator apps
+	Issuer = "Pixel Plaza"
+
+	// Period is the number of seconds each TOTP code is valid for
+	Period = 30
+
+	// Skew is the number of periods before and after the current one whose codes are also accepted
+	Skew = 1
+
+	// RecoveryCodesCount is the number of recovery codes generated when the TOTP is confirmed
+	RecoveryCodesCount = 10
+
+	// recoveryCodeLength is the number of characters of a recovery code, without the separator
+	recoveryCodeLength = 10
+
+	// MaxAttempts is the number of failed second factor attempts before the second factor is locked
+	MaxAttempts = 5
+
+	// LockoutDuration is the time the second factor is locked after too many failed attempts
+	LockoutDuration = 15 * time.Minute
+)
@@ -0,0 +1,99 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// validateOpts are the options of the TOTP codes
+var validateOpts = totp.ValidateOpts{
+	Period:    Period,
+	Skew:      Skew,
+	Digits:    otp.DigitsSix,
+	Algorithm: otp.AlgorithmSHA1,
+}
+
+// GenerateKey generates a TOTP secret for the account, and its otpauth URI for the authenticator apps
+func GenerateKey(accountName string) (secret string, uri string, err error) {
+	key, err := totp.Generate(
+		totp.GenerateOpts{
+			Issuer:      Issuer,
+			AccountName: accountName,
+			Period:      Period,
+			Digits:      validateOpts.Digits,
+			Algorithm:   validateOpts.Algorithm,
+		},
+	)
+	if err != nil {
+		return "", "", err
+	}
+	return key.Secret(), key.URL(), nil
+}
+
+// IsTOTPCode checks if the code has the format of a TOTP code instead of a recovery code
+func IsTOTPCode(code string) bool {
+	if len(code) != validateOpts.Digits.Length() {
+		return false
+	}
+	for _, r := range code {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchTOTPCode checks if the code matches the secret at any of the accepted periods after the last used one, and
+// returns the matched period, so the same code can't be used twice
+func MatchTOTPCode(secret string, code string, now time.Time, lastUsedStep int64) (step int64, ok bool) {
+	currentStep := now.Unix() / Period
+	for offset := int64(-Skew); offset <= Skew; offset++ {
+		step = currentStep + offset
+		if step <= lastUsedStep {
+			continue
+		}
+
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(step*Period, 0), validateOpts)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// GenerateRecoveryCodes generates the one-time recovery codes, formatted in two groups separated by a hyphen
+func GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, RecoveryCodesCount)
+	for i := range codes {
+		buffer := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(buffer); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buffer))
+		code = code[:recoveryCodeLength]
+		codes[i] = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode removes the separators and spaces of the recovery code and lowercases it, so it can be
+// compared with the hashed codes
+func NormalizeRecoveryCode(code string) string {
+	return strings.Map(
+		func(r rune) rune {
+			if r == '-' || unicode.IsSpace(r) {
+				return -1
+			}
+			return unicode.ToLower(r)
+		}, code,
+	)
+}
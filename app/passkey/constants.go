@@ -0,0 +1,32 @@
+package passkey
+
+import "time"
+
+const (
+	// RPIDKey is the key of the WebAuthn relying party ID, the passkeys are disabled if it is not set
+	RPIDKey = "USER_SERVICE_PASSKEY_RP_ID"
+
+	// RPDisplayNameKey is the key of the WebAuthn relying party name shown by the authenticators
+	RPDisplayNameKey = "USER_SERVICE_PASSKEY_RP_DISPLAY_NAME"
+
+	// RPOriginsKey is the key of the comma-separated origins allowed to run the ceremonies
+	RPOriginsKey = "USER_SERVICE_PASSKEY_RP_ORIGINS"
+
+	// SessionTTLKey is the key of the time a ceremony can be finished after it was started
+	SessionTTLKey = "USER_SERVICE_PASSKEY_SESSION_TTL"
+
+	// RPDisplayName is the default relying party name shown by the authenticators
+	RPDisplayName = "Pixel Plaza"
+
+	// SessionTTL is the default time a ceremony can be finished after it was started
+	SessionTTL = 5 * time.Minute
+
+	// MaxNameLength is the maximum length of the friendly name of a passkey
+	MaxNameLength = 64
+)
+
+// Ceremonies
+const (
+	CeremonyRegistration = "registration"
+	CeremonyAssertion    = "assertion"
+)
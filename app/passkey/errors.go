@@ -0,0 +1,8 @@
+package passkey
+
+import "errors"
+
+var (
+	MissingOriginsError      = errors.New("at least one origin is required")
+	ClonedAuthenticatorError = errors.New("the sign count did not increase, the authenticator may have been cloned")
+)
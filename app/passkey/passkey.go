@@ -0,0 +1,107 @@
+package passkey
+
+import (
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"strings"
+)
+
+// User is the user whose passkeys take part in a ceremony
+type User struct {
+	ID          string
+	Username    string
+	Credentials []webauthn.Credential
+}
+
+// WebAuthnID returns the user handle, which is the user ID
+func (u *User) WebAuthnID() []byte {
+	return []byte(u.ID)
+}
+
+// WebAuthnName returns the username
+func (u *User) WebAuthnName() string {
+	return u.Username
+}
+
+// WebAuthnDisplayName returns the username, since the names may be hidden by the profile visibility
+func (u *User) WebAuthnDisplayName() string {
+	return u.Username
+}
+
+// WebAuthnCredentials returns the user's registered passkeys
+func (u *User) WebAuthnCredentials() []webauthn.Credential {
+	return u.Credentials
+}
+
+// WebAuthnIcon returns an empty icon, since it was removed from the specification
+func (u *User) WebAuthnIcon() string {
+	return ""
+}
+
+// ParseOrigins parses the comma-separated origins
+func ParseOrigins(origins string) ([]string, error) {
+	var parsedOrigins []string
+	for _, origin := range strings.Split(origins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			parsedOrigins = append(parsedOrigins, origin)
+		}
+	}
+	if len(parsedOrigins) == 0 {
+		return nil, MissingOriginsError
+	}
+	return parsedOrigins, nil
+}
+
+// NewWebAuthn creates the WebAuthn relying party that runs the ceremonies
+func NewWebAuthn(rpId string, rpDisplayName string, origins string) (*webauthn.WebAuthn, error) {
+	rpOrigins, err := ParseOrigins(origins)
+	if err != nil {
+		return nil, err
+	}
+
+	return webauthn.New(
+		&webauthn.Config{
+			RPID:          rpId,
+			RPDisplayName: rpDisplayName,
+			RPOrigins:     rpOrigins,
+		},
+	)
+}
+
+// FinishRegistration verifies the authenticator response to a registration ceremony, and returns the new passkey
+func FinishRegistration(
+	webAuthn *webauthn.WebAuthn,
+	user *User,
+	session webauthn.SessionData,
+	response string,
+) (*webauthn.Credential, error) {
+	parsedResponse, err := protocol.ParseCredentialCreationResponseBody(strings.NewReader(response))
+	if err != nil {
+		return nil, err
+	}
+	return webAuthn.CreateCredential(user, session, parsedResponse)
+}
+
+// FinishAssertion verifies the authenticator response to an assertion ceremony, and returns the used passkey with its
+// new sign count. If the sign count did not increase, the passkey is also returned along with a
+// ClonedAuthenticatorError, so it can be flagged
+func FinishAssertion(
+	webAuthn *webauthn.WebAuthn,
+	user *User,
+	session webauthn.SessionData,
+	response string,
+) (*webauthn.Credential, error) {
+	parsedResponse, err := protocol.ParseCredentialRequestResponseBody(strings.NewReader(response))
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := webAuthn.ValidateLogin(user, session, parsedResponse)
+	if err != nil {
+		return nil, err
+	}
+	if credential.Authenticator.CloneWarning {
+		return credential, ClonedAuthenticatorError
+	}
+	return credential, nil
+}
@@ -0,0 +1,204 @@
+package passkey
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	testRPID     = "pixelplaza.test"
+	testOrigin   = "https://pixelplaza.test"
+	testUserId   = "6716f0a2c4b1e8a9d3f20b11"
+	testUsername = "janedoe"
+)
+
+// ceremony is a recorded ceremony, with the session stored when it was started and the authenticator response
+type ceremony struct {
+	Session  webauthn.SessionData `json:"session"`
+	Response json.RawMessage      `json:"response"`
+}
+
+// loadCeremony loads a recorded ceremony from the testdata directory
+func loadCeremony(t *testing.T, name string) *ceremony {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read the %s fixture: %v", name, err)
+	}
+
+	var recorded ceremony
+	if err = json.Unmarshal(data, &recorded); err != nil {
+		t.Fatalf("failed to parse the %s fixture: %v", name, err)
+	}
+	return &recorded
+}
+
+// newTestWebAuthn creates the relying party the fixtures were recorded with
+func newTestWebAuthn(t *testing.T) *webauthn.WebAuthn {
+	t.Helper()
+
+	webAuthn, err := NewWebAuthn(testRPID, RPDisplayName, testOrigin)
+	if err != nil {
+		t.Fatalf("failed to create the relying party: %v", err)
+	}
+	return webAuthn
+}
+
+// registerTestCredential registers the passkey of the recorded registration
+func registerTestCredential(t *testing.T, webAuthn *webauthn.WebAuthn) *webauthn.Credential {
+	t.Helper()
+
+	registration := loadCeremony(t, "registration.json")
+	credential, err := FinishRegistration(
+		webAuthn,
+		&User{ID: testUserId, Username: testUsername},
+		registration.Session,
+		string(registration.Response),
+	)
+	if err != nil {
+		t.Fatalf("failed to register the passkey: %v", err)
+	}
+	return credential
+}
+
+func TestFinishRegistration(t *testing.T) {
+	webAuthn := newTestWebAuthn(t)
+
+	tests := []struct {
+		name    string
+		user    *User
+		session func(session webauthn.SessionData) webauthn.SessionData
+		wantErr bool
+	}{
+		{
+			name:    "Valid",
+			user:    &User{ID: testUserId, Username: testUsername},
+			session: func(session webauthn.SessionData) webauthn.SessionData { return session },
+		},
+		{
+			name: "ChallengeMismatch",
+			user: &User{ID: testUserId, Username: testUsername},
+			session: func(session webauthn.SessionData) webauthn.SessionData {
+				session.Challenge = "dGFtcGVyZWQtY2hhbGxlbmdl"
+				return session
+			},
+			wantErr: true,
+		},
+		{
+			name:    "UserMismatch",
+			user:    &User{ID: "6716f0a2c4b1e8a9d3f20b12", Username: testUsername},
+			session: func(session webauthn.SessionData) webauthn.SessionData { return session },
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				registration := loadCeremony(t, "registration.json")
+
+				credential, err := FinishRegistration(
+					webAuthn,
+					test.user,
+					test.session(registration.Session),
+					string(registration.Response),
+				)
+				if test.wantErr {
+					if err == nil {
+						t.Fatal("the registration was accepted")
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("the registration was rejected: %v", err)
+				}
+				if len(credential.ID) == 0 || len(credential.PublicKey) == 0 {
+					t.Error("the passkey has no ID or public key")
+				}
+				if credential.Authenticator.SignCount != 1 {
+					t.Errorf("the sign count is %d, want 1", credential.Authenticator.SignCount)
+				}
+			},
+		)
+	}
+}
+
+func TestFinishAssertion(t *testing.T) {
+	webAuthn := newTestWebAuthn(t)
+	registered := registerTestCredential(t, webAuthn)
+
+	tests := []struct {
+		name        string
+		credentials func() []webauthn.Credential
+		wantErr     error
+		wantInvalid bool
+	}{
+		{
+			name:        "Valid",
+			credentials: func() []webauthn.Credential { return []webauthn.Credential{*registered} },
+		},
+		{
+			name: "SignCountRegression",
+			credentials: func() []webauthn.Credential {
+				credential := *registered
+				credential.Authenticator.SignCount = 2
+				return []webauthn.Credential{credential}
+			},
+			wantErr: ClonedAuthenticatorError,
+		},
+		{
+			name: "FlaggedAsCloned",
+			credentials: func() []webauthn.Credential {
+				credential := *registered
+				credential.Authenticator.CloneWarning = true
+				return []webauthn.Credential{credential}
+			},
+			wantErr: ClonedAuthenticatorError,
+		},
+		{
+			name:        "RevokedCredential",
+			credentials: func() []webauthn.Credential { return nil },
+			wantInvalid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				assertion := loadCeremony(t, "assertion.json")
+
+				credential, err := FinishAssertion(
+					webAuthn,
+					&User{ID: testUserId, Username: testUsername, Credentials: test.credentials()},
+					assertion.Session,
+					string(assertion.Response),
+				)
+				switch {
+				case test.wantInvalid:
+					if err == nil || errors.Is(err, ClonedAuthenticatorError) {
+						t.Fatalf("the assertion was not rejected as invalid: %v", err)
+					}
+				case test.wantErr != nil:
+					if !errors.Is(err, test.wantErr) {
+						t.Fatalf("got error %v, want %v", err, test.wantErr)
+					}
+					if credential == nil {
+						t.Fatal("the flagged passkey was not returned")
+					}
+				default:
+					if err != nil {
+						t.Fatalf("the assertion was rejected: %v", err)
+					}
+					if credential.Authenticator.SignCount != 2 {
+						t.Errorf("the sign count is %d, want 2", credential.Authenticator.SignCount)
+					}
+				}
+			},
+		)
+	}
+}
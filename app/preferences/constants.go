@@ -0,0 +1,51 @@
+package preferences
+
+// Preference keys, which are also the field mask paths and the stored field names
+const (
+	Locale             = "locale"
+	Timezone           = "timezone"
+	Currency           = "currency"
+	Notifications      = "notifications"
+	NotificationsEmail = "notifications.email"
+	NotificationsSMS   = "notifications.sms"
+	NotificationsPush  = "notifications.push"
+)
+
+// Default preferences
+const (
+	DefaultLocale     = "en-US"
+	DefaultTimezone   = "UTC"
+	DefaultCurrency   = "USD"
+	DefaultEmailOptIn = true
+	DefaultSMSOptIn   = false
+	DefaultPushOptIn  = false
+)
+
+var (
+	// Locales is the list of the supported locales
+	Locales = []string{"en-US", "es-VE", "es-ES", "es-CO", "pt-BR"}
+
+	// Currencies is the list of the supported ISO 4217 currency codes
+	Currencies = []string{"USD", "EUR", "VES", "COP", "BRL"}
+
+	// Paths maps each field mask path to the preference keys it updates
+	Paths = map[string][]string{
+		Locale:             {Locale},
+		Timezone:           {Timezone},
+		Currency:           {Currency},
+		Notifications:      {NotificationsEmail, NotificationsSMS, NotificationsPush},
+		NotificationsEmail: {NotificationsEmail},
+		NotificationsSMS:   {NotificationsSMS},
+		NotificationsPush:  {NotificationsPush},
+	}
+
+	// Defaults is the value of each preference key the user has not set
+	Defaults = map[string]interface{}{
+		Locale:             DefaultLocale,
+		Timezone:           DefaultTimezone,
+		Currency:           DefaultCurrency,
+		NotificationsEmail: DefaultEmailOptIn,
+		NotificationsSMS:   DefaultSMSOptIn,
+		NotificationsPush:  DefaultPushOptIn,
+	}
+)
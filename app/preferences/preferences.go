@@ -0,0 +1,45 @@
+package preferences
+
+import (
+	"slices"
+	"time"
+	_ "time/tzdata"
+)
+
+// IsValidLocale checks if the locale is supported
+func IsValidLocale(locale string) bool {
+	return slices.Contains(Locales, locale)
+}
+
+// IsValidCurrency checks if the currency is supported
+func IsValidCurrency(currency string) bool {
+	return slices.Contains(Currencies, currency)
+}
+
+// IsValidTimezone checks if the timezone is an IANA time zone name
+func IsValidTimezone(timezone string) bool {
+	if timezone == "" || timezone == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(timezone)
+	return err == nil
+}
+
+// IsValidPath checks if the field mask path is a preference key
+func IsValidPath(path string) bool {
+	_, ok := Paths[path]
+	return ok
+}
+
+// Keys returns the preference keys updated by the field mask paths, without duplicates
+func Keys(paths []string) []string {
+	var keys []string
+	for _, path := range paths {
+		for _, key := range Paths[path] {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
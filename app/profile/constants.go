@@ -0,0 +1,35 @@
+package profile
+
+// Profile fields, which are also the field mask paths and the stored field names
+const (
+	FirstName   = "first_name"
+	LastName    = "last_name"
+	Birthdate   = "birthdate"
+	Bio         = "bio"
+	Location    = "location"
+	Website     = "website"
+	SocialLinks = "social_links"
+	Pronouns    = "pronouns"
+	DisplayName = "display_name"
+)
+
+var (
+	// Paths is the list of the field mask paths the user can set
+	Paths = []string{
+		FirstName,
+		LastName,
+		Birthdate,
+		Bio,
+		Location,
+		Website,
+		SocialLinks,
+		Pronouns,
+		DisplayName,
+	}
+
+	// RequiredPaths is the list of the field mask paths the user can set but not clear
+	RequiredPaths = []string{
+		FirstName,
+		LastName,
+	}
+)
@@ -0,0 +1,21 @@
+package profile
+
+// IsValidPath checks if the field mask path is a profile field the user can set
+func IsValidPath(path string) bool {
+	for _, p := range Paths {
+		if path == p {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRequiredPath checks if the field mask path is a profile field the user can't clear
+func IsRequiredPath(path string) bool {
+	for _, p := range RequiredPaths {
+		if path == p {
+			return true
+		}
+	}
+	return false
+}
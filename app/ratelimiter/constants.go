@@ -0,0 +1,70 @@
+package ratelimiter
+
+import (
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	pbconfiguser "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/user"
+	"time"
+)
+
+const (
+	// BackendKey is the key of the rate limiter backend
+	BackendKey = "RATE_LIMITER_BACKEND"
+
+	// RedisUriKey is the key of the Redis URI, required by the Redis backend
+	RedisUriKey = "RATE_LIMITER_REDIS_URI"
+
+	// RedisPasswordKey is the key of the Redis password
+	RedisPasswordKey = "RATE_LIMITER_REDIS_PASSWORD"
+
+	// DefaultLimitKey is the key of the limit of the methods without their own limit
+	DefaultLimitKey = "RATE_LIMITER_DEFAULT_LIMIT"
+
+	// MethodLimitsKey is the key of the comma-separated Method=limit pairs that override the limits of the methods
+	MethodLimitsKey = "RATE_LIMITER_METHOD_LIMITS"
+
+	// ExemptCallersKey is the key of the comma-separated IPs or CIDRs of the services that are not rate limited
+	ExemptCallersKey = "RATE_LIMITER_EXEMPT_CALLERS"
+
+	// KeyPrefix is the prefix of the bucket keys
+	KeyPrefix = "user_service:rate_limiter"
+
+	// SweepInterval is the interval between the removals of the idle in-memory buckets
+	SweepInterval = time.Minute
+)
+
+// Supported rate limiter backends
+const (
+	// BackendMemory keeps the buckets in the memory of each instance
+	BackendMemory = "memory"
+
+	// BackendRedis keeps the buckets in Redis, so the limits hold across instances
+	BackendRedis = "redis"
+)
+
+var (
+	// DefaultLimit is the limit of the methods without their own limit
+	DefaultLimit = Limit{Rate: 10, Burst: 20}
+
+	// MethodLimits are the default limits of the methods that are easy to enumerate or abuse
+	MethodLimits = map[pbtypesgrpc.Method]Limit{
+		pbconfiguser.SignUp:                 {Rate: 5.0 / 60, Burst: 5},
+		pbconfiguser.UsernameExists:         {Rate: 30.0 / 60, Burst: 30},
+		pbconfiguser.IsPasswordCorrect:      {Rate: 10.0 / 60, Burst: 10},
+		pbconfiguser.VerifySecondFactor:     {Rate: 10.0 / 60, Burst: 10},
+		pbconfiguser.BeginPasskeyAssertion:  {Rate: 10.0 / 60, Burst: 10},
+		pbconfiguser.FinishPasskeyAssertion: {Rate: 10.0 / 60, Burst: 10},
+		pbconfiguser.SearchUsers:            {Rate: 30.0 / 60, Burst: 30},
+		pbconfiguser.ForgotPassword:         {Rate: 3.0 / 3600, Burst: 3},
+	}
+
+	// SubjectMethods are the methods keyed by the username, user ID or session in the request instead of the caller.
+	// The auth service calls the login methods on behalf of every user, and the password reset emails are limited
+	// per recipient, so they can't be used to flood a mailbox
+	SubjectMethods = map[pbtypesgrpc.Method]bool{
+		pbconfiguser.IsPasswordCorrect:      true,
+		pbconfiguser.VerifySecondFactor:     true,
+		pbconfiguser.BeginPasskeyAssertion:  true,
+		pbconfiguser.FinishPasskeyAssertion: true,
+		pbconfiguser.ForgotPassword:         true,
+	}
+)
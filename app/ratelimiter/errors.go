@@ -0,0 +1,10 @@
+package ratelimiter
+
+import "errors"
+
+var (
+	UnknownBackendError     = errors.New("unknown rate limiter backend")
+	InvalidLimitError       = errors.New("rate limit must have a positive rate and burst")
+	InvalidLimitFormatError = errors.New("rate limit must be a positive burst and refill period, like 10/1m")
+	UnexpectedReplyError    = errors.New("unexpected rate limiter reply")
+)
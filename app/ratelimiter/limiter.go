@@ -0,0 +1,80 @@
+package ratelimiter
+
+import (
+	"context"
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	appgrpc "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc"
+	"maps"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// Limit is the token bucket limit, the bucket holds up to Burst tokens and refills Rate tokens per second
+	Limit struct {
+		Rate  float64
+		Burst int
+	}
+
+	// Limiter interface
+	Limiter interface {
+		Allow(ctx context.Context, key string, limit Limit) (allowed bool, retryAfter time.Duration, err error)
+	}
+)
+
+// IsValid checks if the limit has a positive rate and burst
+func (l Limit) IsValid() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+// RefillDuration returns the time a bucket takes to go from empty to full
+func (l Limit) RefillDuration() time.Duration {
+	return time.Duration(math.Ceil(float64(l.Burst) / l.Rate * float64(time.Second)))
+}
+
+// RetryAfter returns the time until the bucket with the given tokens has a whole token
+func (l Limit) RetryAfter(tokens float64) time.Duration {
+	return time.Duration(math.Ceil((1 - tokens) / l.Rate * float64(time.Second)))
+}
+
+// String returns the limit as its burst and the time the bucket takes to refill, like 10/1m
+func (l Limit) String() string {
+	return strconv.Itoa(l.Burst) + "/" + l.RefillDuration().String()
+}
+
+// ParseLimit parses a limit written as its burst and the time the bucket takes to refill, like 10/1m
+func ParseLimit(value string) (Limit, error) {
+	burst, period, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return Limit{}, InvalidLimitFormatError
+	}
+
+	parsedBurst, err := strconv.Atoi(burst)
+	if err != nil || parsedBurst <= 0 {
+		return Limit{}, InvalidLimitFormatError
+	}
+	parsedPeriod, err := time.ParseDuration(period)
+	if err != nil || parsedPeriod <= 0 {
+		return Limit{}, InvalidLimitFormatError
+	}
+
+	return Limit{Rate: float64(parsedBurst) / parsedPeriod.Seconds(), Burst: parsedBurst}, nil
+}
+
+// NewMethodLimits returns the default method limits with the comma-separated Method=limit pairs applied over them
+func NewMethodLimits(overrides string) (*map[pbtypesgrpc.Method]Limit, error) {
+	values, err := appgrpc.ParseMethodValues(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	methodLimits := maps.Clone(MethodLimits)
+	for method, value := range values {
+		if methodLimits[method], err = ParseLimit(value); err != nil {
+			return nil, err
+		}
+	}
+	return &methodLimits, nil
+}
@@ -0,0 +1,80 @@
+package ratelimiter
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+type (
+	// bucket is an in-memory token bucket
+	bucket struct {
+		tokens    float64
+		updatedAt time.Time
+		limit     Limit
+	}
+
+	// MemoryLimiter is the rate limiter that keeps the buckets in memory
+	MemoryLimiter struct {
+		mutex   sync.Mutex
+		buckets map[string]*bucket
+		sweptAt time.Time
+		timeNow func() time.Time
+	}
+)
+
+// NewMemoryLimiter creates a new in-memory rate limiter
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+		sweptAt: time.Now(),
+	}
+}
+
+// Allow takes a token from the bucket of the key
+func (m *MemoryLimiter) Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	if !limit.IsValid() {
+		return false, 0, InvalidLimitError
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := time.Now()
+	m.sweep(now)
+
+	// Get the bucket of the key, a new bucket starts full
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updatedAt: now, limit: limit}
+		m.buckets[key] = b
+	}
+
+	// Refill the bucket with the tokens accumulated since the last update
+	elapsed := now.Sub(b.updatedAt).Seconds()
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+	b.updatedAt = now
+	b.limit = limit
+
+	// Check if there is a whole token
+	if b.tokens < 1 {
+		return false, limit.RetryAfter(b.tokens), nil
+	}
+	b.tokens--
+	return true, 0, nil
+}
+
+// sweep removes the buckets that are full again, since they are the same as a new bucket
+func (m *MemoryLimiter) sweep(now time.Time) {
+	if now.Sub(m.sweptAt) < SweepInterval {
+		return
+	}
+	m.sweptAt = now
+
+	for key, b := range m.buckets {
+		if now.Sub(b.updatedAt) >= b.limit.RefillDuration() {
+			delete(m.buckets, key)
+		}
+	}
+}
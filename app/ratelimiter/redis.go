@@ -0,0 +1,83 @@
+package ratelimiter
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	commonredis "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/redis"
+	"time"
+)
+
+// tokenBucketScript takes a token from the bucket stored in a hash, refilling it first with the tokens
+// accumulated since its last update. It returns whether the token was taken and the milliseconds until the
+// next whole token. The time of the Redis server is used, so every instance shares the same clock
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local ttl = tonumber(ARGV[3])
+
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated_at")
+local tokens = tonumber(bucket[1])
+local updated_at = tonumber(bucket[2])
+if tokens == nil or updated_at == nil then
+	tokens = burst
+	updated_at = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - updated_at) * rate / 1000)
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated_at", now)
+redis.call("PEXPIRE", KEYS[1], ttl)
+
+return {allowed, retry_after}
+`)
+
+// RedisLimiter is the rate limiter that keeps the buckets in Redis
+type RedisLimiter struct {
+	redisClient *redis.Client
+}
+
+// NewRedisLimiter creates a new Redis rate limiter
+func NewRedisLimiter(redisClient *redis.Client) (*RedisLimiter, error) {
+	// Check if the Redis client is nil
+	if redisClient == nil {
+		return nil, commonredis.NilClientError
+	}
+
+	return &RedisLimiter{redisClient: redisClient}, nil
+}
+
+// Allow takes a token from the bucket of the key
+func (r *RedisLimiter) Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	if !limit.IsValid() {
+		return false, 0, InvalidLimitError
+	}
+
+	reply, err := tokenBucketScript.Run(
+		ctx,
+		r.redisClient,
+		[]string{commonredis.GetKey(key, KeyPrefix)},
+		limit.Rate,
+		limit.Burst,
+		limit.RefillDuration().Milliseconds()+1,
+	).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+	if len(reply) != 2 {
+		return false, 0, UnexpectedReplyError
+	}
+
+	return reply[0] == 1, time.Duration(reply[1]) * time.Millisecond, nil
+}
@@ -0,0 +1,135 @@
+package role
+
+import (
+	"context"
+	pbauth "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/compiled/pixel_plaza/auth"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"slices"
+)
+
+// Client manages the roles of the users through the auth service, which stores them and the permissions they grant.
+// The given contexts must be outgoing contexts with the caller's access token
+type Client struct {
+	authClient pbauth.AuthClient
+}
+
+// NewClient creates a new roles client
+func NewClient(authClient pbauth.AuthClient) (*Client, error) {
+	// Check if the auth client is nil
+	if authClient == nil {
+		return nil, NilAuthClientError
+	}
+
+	return &Client{authClient: authClient}, nil
+}
+
+// getRoleIds gets the IDs of the roles registered in the auth service by their name
+func (c *Client) getRoleIds(ctx context.Context) (map[string]string, error) {
+	response, err := c.authClient.GetRoles(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	roleIds := make(map[string]string, len(response.GetRoles()))
+	for _, role := range response.GetRoles() {
+		roleIds[role.GetName()] = role.GetRoleId()
+	}
+	return roleIds, nil
+}
+
+// getRoleId gets the ID of the role registered in the auth service
+func (c *Client) getRoleId(ctx context.Context, role string) (string, error) {
+	roleIds, err := c.getRoleIds(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	roleId, ok := roleIds[role]
+	if !ok {
+		return "", RoleNotRegisteredError
+	}
+	return roleId, nil
+}
+
+// GetUserRoles gets the names of the user's roles
+func (c *Client) GetUserRoles(ctx context.Context, userId string) ([]string, error) {
+	response, err := c.authClient.GetUserRoles(ctx, &pbauth.GetUserRolesRequest{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+
+	roleIds, err := c.getRoleIds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]string, 0, len(response.GetRolesId()))
+	for role, roleId := range roleIds {
+		if slices.Contains(response.GetRolesId(), roleId) {
+			roles = append(roles, role)
+		}
+	}
+	slices.Sort(roles)
+	return roles, nil
+}
+
+// AddUserRole grants the role to the user
+func (c *Client) AddUserRole(ctx context.Context, userId string, role string) error {
+	roleId, err := c.getRoleId(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.authClient.AddUserRole(ctx, &pbauth.AddUserRoleRequest{UserId: userId, RoleId: roleId})
+	return err
+}
+
+// RevokeUserRole revokes the role from the user
+func (c *Client) RevokeUserRole(ctx context.Context, userId string, role string) error {
+	roleId, err := c.getRoleId(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.authClient.RevokeUserRole(ctx, &pbauth.RevokeUserRoleRequest{UserId: userId, RoleId: roleId})
+	return err
+}
+
+// HasPermission checks if any of the user's roles grants the permission
+func (c *Client) HasPermission(ctx context.Context, userId string, permission Permission) (bool, error) {
+	// Get the IDs the permission is registered with
+	permissionsResponse, err := c.authClient.GetPermissions(ctx, &emptypb.Empty{})
+	if err != nil {
+		return false, err
+	}
+	var permissionIds []string
+	for _, p := range permissionsResponse.GetPermission() {
+		if permission.Matches(p) {
+			permissionIds = append(permissionIds, p.GetPermissionId())
+		}
+	}
+	if len(permissionIds) == 0 {
+		return false, nil
+	}
+
+	// Check the permissions of each of the user's roles
+	rolesResponse, err := c.authClient.GetUserRoles(ctx, &pbauth.GetUserRolesRequest{UserId: userId})
+	if err != nil {
+		return false, err
+	}
+	for _, roleId := range rolesResponse.GetRolesId() {
+		rolePermissionsResponse, err := c.authClient.GetRolePermissions(
+			ctx,
+			&pbauth.GetRolePermissionsRequest{RoleId: roleId},
+		)
+		if err != nil {
+			return false, err
+		}
+		for _, permissionId := range rolePermissionsResponse.GetPermissionsId() {
+			if slices.Contains(permissionIds, permissionId) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
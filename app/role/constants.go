@@ -0,0 +1,38 @@
+package role
+
+// Roles of the marketplace users, registered by name in the auth service, which stores the roles of each user
+const (
+	// Buyer is the role of the users who buy products
+	Buyer = "buyer"
+
+	// Seller is the role of the users who sell products
+	Seller = "seller"
+
+	// Moderator is the role of the staff who moderates the users
+	Moderator = "moderator"
+
+	// Admin is the role of the support staff
+	Admin = "admin"
+)
+
+// Resources of the user service permissions
+const (
+	UsersResource = "users"
+	RolesResource = "roles"
+)
+
+var (
+	// Roles are the valid roles
+	Roles = []string{Buyer, Seller, Moderator, Admin}
+)
+
+// Permissions of the user service, registered in the auth service and granted to the roles through it
+var (
+	ReadUsers          = Permission{Resource: UsersResource, Action: "read"}
+	LockUsers          = Permission{Resource: UsersResource, Action: "lock"}
+	ResetUserPasswords = Permission{Resource: UsersResource, Action: "reset_password"}
+	RestoreUsers       = Permission{Resource: UsersResource, Action: "restore"}
+	RevokeUserSessions = Permission{Resource: UsersResource, Action: "revoke_sessions"}
+	ReadUserRoles      = Permission{Resource: RolesResource, Action: "read"}
+	ManageUserRoles    = Permission{Resource: RolesResource, Action: "manage"}
+)
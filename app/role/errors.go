@@ -0,0 +1,8 @@
+package role
+
+import "errors"
+
+var (
+	NilAuthClientError     = errors.New("auth client cannot be nil")
+	RoleNotRegisteredError = errors.New("role is not registered in the auth service")
+)
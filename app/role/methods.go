@@ -0,0 +1,22 @@
+package role
+
+import (
+	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+	pbconfiguseradmin "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/useradmin"
+)
+
+// MethodPermissions are the permissions required by the gRPC methods
+var MethodPermissions = map[pbtypesgrpc.Method]Permission{
+	pbconfiguseradmin.LookUpUser:                ReadUsers,
+	pbconfiguseradmin.GetUserEmailHistory:       ReadUsers,
+	pbconfiguseradmin.GetUserPhoneNumberHistory: ReadUsers,
+	pbconfiguseradmin.GetUserUsernameHistory:    ReadUsers,
+	pbconfiguseradmin.LockUser:                  LockUsers,
+	pbconfiguseradmin.UnlockUser:                LockUsers,
+	pbconfiguseradmin.ForcePasswordReset:        ResetUserPasswords,
+	pbconfiguseradmin.RestoreUser:               RestoreUsers,
+	pbconfiguseradmin.RevokeUserSessions:        RevokeUserSessions,
+	pbconfiguseradmin.ListUserRoles:             ReadUserRoles,
+	pbconfiguseradmin.GrantUserRole:             ManageUserRoles,
+	pbconfiguseradmin.RevokeUserRole:            ManageUserRoles,
+}
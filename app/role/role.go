@@ -0,0 +1,22 @@
+package role
+
+import (
+	pbauth "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/compiled/pixel_plaza/auth"
+	"slices"
+)
+
+// Permission is an action on a resource of the user service
+type Permission struct {
+	Resource string
+	Action   string
+}
+
+// Matches checks if the permission registered in the auth service is this permission
+func (p Permission) Matches(permission *pbauth.Permission) bool {
+	return permission.GetResource() == p.Resource && permission.GetAction() == p.Action
+}
+
+// IsValid checks if the role exists
+func IsValid(role string) bool {
+	return slices.Contains(Roles, role)
+}
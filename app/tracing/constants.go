@@ -0,0 +1,24 @@
+package tracing
+
+const (
+	// ExporterKey is the key of the traces exporter
+	ExporterKey = "OTEL_TRACES_EXPORTER"
+
+	// ServiceName is the name of the service reported in the traces
+	ServiceName = "user-service"
+
+	// TracerName is the name of the tracer used for the explicit spans
+	TracerName = "github.com/pixel-plaza-dev/uru-databases-2-user-service"
+)
+
+// Supported traces exporters
+const (
+	// ExporterOTLP exports the traces over OTLP gRPC, configured with the OTEL_EXPORTER_OTLP_* variables
+	ExporterOTLP = "otlp"
+
+	// ExporterStdout writes the traces to the standard output
+	ExporterStdout = "stdout"
+
+	// ExporterNone creates the spans without exporting them
+	ExporterNone = "none"
+)
@@ -0,0 +1,7 @@
+package tracing
+
+import "errors"
+
+var (
+	UnknownExporterError = errors.New("unknown traces exporter")
+)
@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTracerProvider creates the tracer provider for the given exporter and sets it as the global one
+func NewTracerProvider(
+	ctx context.Context,
+	exporter string,
+) (*sdktrace.TracerProvider, error) {
+	// Create the resource that identifies the service
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(ServiceName),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the span exporter
+	options := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	switch exporter {
+	case ExporterOTLP:
+		spanExporter, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, sdktrace.WithBatcher(spanExporter))
+	case ExporterStdout:
+		spanExporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, sdktrace.WithSyncer(spanExporter))
+	case ExporterNone:
+	default:
+		return nil, UnknownExporterError
+	}
+
+	// Set the global tracer provider and the W3C trace context propagator
+	tracerProvider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(
+		propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		),
+	)
+
+	return tracerProvider, nil
+}
+
+// StartSpan starts a span with the service tracer
+func StartSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name)
+}
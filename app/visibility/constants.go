@@ -0,0 +1,41 @@
+package visibility
+
+// Visibility levels of the profile fields
+const (
+	// Public fields are visible to every caller
+	Public = "public"
+
+	// Authenticated fields are visible to the callers with an access token
+	Authenticated = "authenticated"
+
+	// Private fields are only visible to the owner
+	Private = "private"
+)
+
+// Profile fields with a visibility setting
+const (
+	FirstName   = "first_name"
+	LastName    = "last_name"
+	Birthdate   = "birthdate"
+	JoinedAt    = "joined_at"
+	Bio         = "bio"
+	Location    = "location"
+	Website     = "website"
+	SocialLinks = "social_links"
+	Pronouns    = "pronouns"
+	DisplayName = "display_name"
+)
+
+// DefaultProfileVisibility is the visibility of the profile fields the user has not set
+var DefaultProfileVisibility = map[string]string{
+	FirstName:   Public,
+	LastName:    Public,
+	Birthdate:   Private,
+	JoinedAt:    Public,
+	Bio:         Public,
+	Location:    Public,
+	Website:     Public,
+	SocialLinks: Public,
+	Pronouns:    Public,
+	DisplayName: Public,
+}
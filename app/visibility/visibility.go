@@ -0,0 +1,34 @@
+package visibility
+
+// IsValid checks if the visibility level exists
+func IsValid(level string) bool {
+	return level == Public || level == Authenticated || level == Private
+}
+
+// Get returns the visibility of the profile field, or its default if the user has not set it
+func Get(settings map[string]string, field string) string {
+	if level, ok := settings[field]; ok && IsValid(level) {
+		return level
+	}
+	return DefaultProfileVisibility[field]
+}
+
+// IsVisible checks if the profile field is visible to the caller, depending on whether the caller is authenticated
+func IsVisible(settings map[string]string, field string, authenticated bool) bool {
+	switch Get(settings, field) {
+	case Public:
+		return true
+	case Authenticated:
+		return authenticated
+	default:
+		return false
+	}
+}
+
+// Hidden returns the visibility levels of the fields hidden from the caller
+func Hidden(authenticated bool) []string {
+	if authenticated {
+		return []string{Private}
+	}
+	return []string{Authenticated, Private}
+}
@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: proto/pixel_plaza/user_admin.proto
+
+package useradmin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserAdmin_LookUpUser_FullMethodName                = "/pixel_plaza.user_admin.UserAdmin/LookUpUser"
+	UserAdmin_GetUserEmailHistory_FullMethodName       = "/pixel_plaza.user_admin.UserAdmin/GetUserEmailHistory"
+	UserAdmin_GetUserPhoneNumberHistory_FullMethodName = "/pixel_plaza.user_admin.UserAdmin/GetUserPhoneNumberHistory"
+	UserAdmin_GetUserUsernameHistory_FullMethodName    = "/pixel_plaza.user_admin.UserAdmin/GetUserUsernameHistory"
+	UserAdmin_LockUser_FullMethodName                  = "/pixel_plaza.user_admin.UserAdmin/LockUser"
+	UserAdmin_UnlockUser_FullMethodName                = "/pixel_plaza.user_admin.UserAdmin/UnlockUser"
+	UserAdmin_ForcePasswordReset_FullMethodName        = "/pixel_plaza.user_admin.UserAdmin/ForcePasswordReset"
+	UserAdmin_RestoreUser_FullMethodName               = "/pixel_plaza.user_admin.UserAdmin/RestoreUser"
+	UserAdmin_RevokeUserSessions_FullMethodName        = "/pixel_plaza.user_admin.UserAdmin/RevokeUserSessions"
+	UserAdmin_ListUserRoles_FullMethodName             = "/pixel_plaza.user_admin.UserAdmin/ListUserRoles"
+	UserAdmin_GrantUserRole_FullMethodName             = "/pixel_plaza.user_admin.UserAdmin/GrantUserRole"
+	UserAdmin_RevokeUserRole_FullMethodName            = "/pixel_plaza.user_admin.UserAdmin/RevokeUserRole"
+)
+
+// UserAdminClient is the client API for UserAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserAdminClient interface {
+	LookUpUser(ctx context.Context, in *LookUpUserRequest, opts ...grpc.CallOption) (*LookUpUserResponse, error)
+	GetUserEmailHistory(ctx context.Context, in *GetUserEmailHistoryRequest, opts ...grpc.CallOption) (*GetUserEmailHistoryResponse, error)
+	GetUserPhoneNumberHistory(ctx context.Context, in *GetUserPhoneNumberHistoryRequest, opts ...grpc.CallOption) (*GetUserPhoneNumberHistoryResponse, error)
+	GetUserUsernameHistory(ctx context.Context, in *GetUserUsernameHistoryRequest, opts ...grpc.CallOption) (*GetUserUsernameHistoryResponse, error)
+	LockUser(ctx context.Context, in *LockUserRequest, opts ...grpc.CallOption) (*LockUserResponse, error)
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error)
+	ForcePasswordReset(ctx context.Context, in *ForcePasswordResetRequest, opts ...grpc.CallOption) (*ForcePasswordResetResponse, error)
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
+	RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*RevokeUserSessionsResponse, error)
+	ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRolesResponse, error)
+	GrantUserRole(ctx context.Context, in *GrantUserRoleRequest, opts ...grpc.CallOption) (*GrantUserRoleResponse, error)
+	RevokeUserRole(ctx context.Context, in *RevokeUserRoleRequest, opts ...grpc.CallOption) (*RevokeUserRoleResponse, error)
+}
+
+type userAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserAdminClient(cc grpc.ClientConnInterface) UserAdminClient {
+	return &userAdminClient{cc}
+}
+
+func (c *userAdminClient) LookUpUser(ctx context.Context, in *LookUpUserRequest, opts ...grpc.CallOption) (*LookUpUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookUpUserResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_LookUpUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) GetUserEmailHistory(ctx context.Context, in *GetUserEmailHistoryRequest, opts ...grpc.CallOption) (*GetUserEmailHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserEmailHistoryResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_GetUserEmailHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) GetUserPhoneNumberHistory(ctx context.Context, in *GetUserPhoneNumberHistoryRequest, opts ...grpc.CallOption) (*GetUserPhoneNumberHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPhoneNumberHistoryResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_GetUserPhoneNumberHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) GetUserUsernameHistory(ctx context.Context, in *GetUserUsernameHistoryRequest, opts ...grpc.CallOption) (*GetUserUsernameHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserUsernameHistoryResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_GetUserUsernameHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) LockUser(ctx context.Context, in *LockUserRequest, opts ...grpc.CallOption) (*LockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LockUserResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_LockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockUserResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) ForcePasswordReset(ctx context.Context, in *ForcePasswordResetRequest, opts ...grpc.CallOption) (*ForcePasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForcePasswordResetResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_ForcePasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*RevokeUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserSessionsResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_RevokeUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserRolesResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_ListUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) GrantUserRole(ctx context.Context, in *GrantUserRoleRequest, opts ...grpc.CallOption) (*GrantUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantUserRoleResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_GrantUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminClient) RevokeUserRole(ctx context.Context, in *RevokeUserRoleRequest, opts ...grpc.CallOption) (*RevokeUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserRoleResponse)
+	err := c.cc.Invoke(ctx, UserAdmin_RevokeUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserAdminServer is the server API for UserAdmin service.
+// All implementations must embed UnimplementedUserAdminServer
+// for forward compatibility.
+type UserAdminServer interface {
+	LookUpUser(context.Context, *LookUpUserRequest) (*LookUpUserResponse, error)
+	GetUserEmailHistory(context.Context, *GetUserEmailHistoryRequest) (*GetUserEmailHistoryResponse, error)
+	GetUserPhoneNumberHistory(context.Context, *GetUserPhoneNumberHistoryRequest) (*GetUserPhoneNumberHistoryResponse, error)
+	GetUserUsernameHistory(context.Context, *GetUserUsernameHistoryRequest) (*GetUserUsernameHistoryResponse, error)
+	LockUser(context.Context, *LockUserRequest) (*LockUserResponse, error)
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error)
+	ForcePasswordReset(context.Context, *ForcePasswordResetRequest) (*ForcePasswordResetResponse, error)
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
+	RevokeUserSessions(context.Context, *RevokeUserSessionsRequest) (*RevokeUserSessionsResponse, error)
+	ListUserRoles(context.Context, *ListUserRolesRequest) (*ListUserRolesResponse, error)
+	GrantUserRole(context.Context, *GrantUserRoleRequest) (*GrantUserRoleResponse, error)
+	RevokeUserRole(context.Context, *RevokeUserRoleRequest) (*RevokeUserRoleResponse, error)
+	mustEmbedUnimplementedUserAdminServer()
+}
+
+// UnimplementedUserAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserAdminServer struct{}
+
+func (UnimplementedUserAdminServer) LookUpUser(context.Context, *LookUpUserRequest) (*LookUpUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookUpUser not implemented")
+}
+func (UnimplementedUserAdminServer) GetUserEmailHistory(context.Context, *GetUserEmailHistoryRequest) (*GetUserEmailHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserEmailHistory not implemented")
+}
+func (UnimplementedUserAdminServer) GetUserPhoneNumberHistory(context.Context, *GetUserPhoneNumberHistoryRequest) (*GetUserPhoneNumberHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPhoneNumberHistory not implemented")
+}
+func (UnimplementedUserAdminServer) GetUserUsernameHistory(context.Context, *GetUserUsernameHistoryRequest) (*GetUserUsernameHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserUsernameHistory not implemented")
+}
+func (UnimplementedUserAdminServer) LockUser(context.Context, *LockUserRequest) (*LockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockUser not implemented")
+}
+func (UnimplementedUserAdminServer) UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedUserAdminServer) ForcePasswordReset(context.Context, *ForcePasswordResetRequest) (*ForcePasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForcePasswordReset not implemented")
+}
+func (UnimplementedUserAdminServer) RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedUserAdminServer) RevokeUserSessions(context.Context, *RevokeUserSessionsRequest) (*RevokeUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSessions not implemented")
+}
+func (UnimplementedUserAdminServer) ListUserRoles(context.Context, *ListUserRolesRequest) (*ListUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserRoles not implemented")
+}
+func (UnimplementedUserAdminServer) GrantUserRole(context.Context, *GrantUserRoleRequest) (*GrantUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantUserRole not implemented")
+}
+func (UnimplementedUserAdminServer) RevokeUserRole(context.Context, *RevokeUserRoleRequest) (*RevokeUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserRole not implemented")
+}
+func (UnimplementedUserAdminServer) mustEmbedUnimplementedUserAdminServer() {}
+func (UnimplementedUserAdminServer) testEmbeddedByValue()                   {}
+
+// UnsafeUserAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserAdminServer will
+// result in compilation errors.
+type UnsafeUserAdminServer interface {
+	mustEmbedUnimplementedUserAdminServer()
+}
+
+func RegisterUserAdminServer(s grpc.ServiceRegistrar, srv UserAdminServer) {
+	// If the following call pancis, it indicates UnimplementedUserAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserAdmin_ServiceDesc, srv)
+}
+
+func _UserAdmin_LookUpUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookUpUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).LookUpUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_LookUpUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).LookUpUser(ctx, req.(*LookUpUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_GetUserEmailHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserEmailHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).GetUserEmailHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_GetUserEmailHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).GetUserEmailHistory(ctx, req.(*GetUserEmailHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_GetUserPhoneNumberHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPhoneNumberHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).GetUserPhoneNumberHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_GetUserPhoneNumberHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).GetUserPhoneNumberHistory(ctx, req.(*GetUserPhoneNumberHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_GetUserUsernameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserUsernameHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).GetUserUsernameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_GetUserUsernameHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).GetUserUsernameHistory(ctx, req.(*GetUserUsernameHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_LockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).LockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_LockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).LockUser(ctx, req.(*LockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_ForcePasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForcePasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).ForcePasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_ForcePasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).ForcePasswordReset(ctx, req.(*ForcePasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_RevokeUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).RevokeUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_RevokeUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).RevokeUserSessions(ctx, req.(*RevokeUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_ListUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).ListUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_ListUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).ListUserRoles(ctx, req.(*ListUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_GrantUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).GrantUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_GrantUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).GrantUserRole(ctx, req.(*GrantUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdmin_RevokeUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServer).RevokeUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAdmin_RevokeUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServer).RevokeUserRole(ctx, req.(*RevokeUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserAdmin_ServiceDesc is the grpc.ServiceDesc for UserAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pixel_plaza.user_admin.UserAdmin",
+	HandlerType: (*UserAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LookUpUser",
+			Handler:    _UserAdmin_LookUpUser_Handler,
+		},
+		{
+			MethodName: "GetUserEmailHistory",
+			Handler:    _UserAdmin_GetUserEmailHistory_Handler,
+		},
+		{
+			MethodName: "GetUserPhoneNumberHistory",
+			Handler:    _UserAdmin_GetUserPhoneNumberHistory_Handler,
+		},
+		{
+			MethodName: "GetUserUsernameHistory",
+			Handler:    _UserAdmin_GetUserUsernameHistory_Handler,
+		},
+		{
+			MethodName: "LockUser",
+			Handler:    _UserAdmin_LockUser_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _UserAdmin_UnlockUser_Handler,
+		},
+		{
+			MethodName: "ForcePasswordReset",
+			Handler:    _UserAdmin_ForcePasswordReset_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _UserAdmin_RestoreUser_Handler,
+		},
+		{
+			MethodName: "RevokeUserSessions",
+			Handler:    _UserAdmin_RevokeUserSessions_Handler,
+		},
+		{
+			MethodName: "ListUserRoles",
+			Handler:    _UserAdmin_ListUserRoles_Handler,
+		},
+		{
+			MethodName: "GrantUserRole",
+			Handler:    _UserAdmin_GrantUserRole_Handler,
+		},
+		{
+			MethodName: "RevokeUserRole",
+			Handler:    _UserAdmin_RevokeUserRole_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pixel_plaza/user_admin.proto",
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+)
+
+// Interceptions is the list of gRPC methods to intercept
+var Interceptions = map[grpc.Method]grpc.Interception{
+	SignUp:                     grpc.None,
+	UsernameExists:             grpc.None,
+	GetUserIdByUsername:        grpc.None,
+	GetUsernameByUserId:        grpc.None,
+	SearchUsers:                grpc.None,
+	BatchGetUsernamesByUserIds: grpc.None,
+	BatchGetUserIdsByUsernames: grpc.None,
+	IsPasswordCorrect:          grpc.None,
+	GetProfile:                 grpc.None,
+	UpdateUser:                 grpc.AccessToken,
+	SetProfilePicture:          grpc.AccessToken,
+	GetMyProfile:               grpc.AccessToken,
+	GetProfileVisibility:       grpc.AccessToken,
+	UpdateProfileVisibility:    grpc.AccessToken,
+	GetPreferences:             grpc.AccessToken,
+	UpdatePreferences:          grpc.AccessToken,
+	BlockUser:                  grpc.AccessToken,
+	UnblockUser:                grpc.AccessToken,
+	ListBlockedUsers:           grpc.AccessToken,
+	IsBlocked:                  grpc.None,
+	EnrollTOTP:                 grpc.AccessToken,
+	ConfirmTOTP:                grpc.AccessToken,
+	DisableTOTP:                grpc.AccessToken,
+	VerifySecondFactor:         grpc.None,
+	BeginPasskeyRegistration:   grpc.AccessToken,
+	FinishPasskeyRegistration:  grpc.AccessToken,
+	BeginPasskeyAssertion:      grpc.None,
+	FinishPasskeyAssertion:     grpc.None,
+	ListPasskeys:               grpc.AccessToken,
+	RevokePasskey:              grpc.AccessToken,
+	ChangePassword:             grpc.AccessToken,
+	ChangeUsername:             grpc.AccessToken,
+	AddEmail:                   grpc.AccessToken,
+	DeleteEmail:                grpc.AccessToken,
+	SendVerificationEmail:      grpc.AccessToken,
+	VerifyEmail:                grpc.AccessToken,
+	GetPrimaryEmail:            grpc.AccessToken,
+	ChangePrimaryEmail:         grpc.AccessToken,
+	GetActiveEmails:            grpc.AccessToken,
+	ChangePhoneNumber:          grpc.AccessToken,
+	GetPhoneNumber:             grpc.AccessToken,
+	SendVerificationSMS:        grpc.AccessToken,
+	VerifyPhoneNumber:          grpc.AccessToken,
+	ForgotPassword:             grpc.None,
+	ResetPassword:              grpc.None,
+	DeleteUser:                 grpc.AccessToken,
+}
+
+// OptionalInterceptions is the list of gRPC methods that don't require a token, but validate it if it's sent
+var OptionalInterceptions = map[grpc.Method]grpc.Interception{
+	SearchUsers: grpc.AccessToken,
+	GetProfile:  grpc.AccessToken,
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+)
+
+// User service gRPC methods
+var (
+	SignUp                     = grpc.NewMethod("SignUp")
+	UsernameExists             = grpc.NewMethod("UsernameExists")
+	GetUserIdByUsername        = grpc.NewMethod("GetUserIdByUsername")
+	GetUsernameByUserId        = grpc.NewMethod("GetUsernameByUserId")
+	SearchUsers                = grpc.NewMethod("SearchUsers")
+	BatchGetUsernamesByUserIds = grpc.NewMethod("BatchGetUsernamesByUserIds")
+	BatchGetUserIdsByUsernames = grpc.NewMethod("BatchGetUserIdsByUsernames")
+	IsPasswordCorrect          = grpc.NewMethod("IsPasswordCorrect")
+	UpdateUser                 = grpc.NewMethod("UpdateUser")
+	SetProfilePicture          = grpc.NewMethod("SetProfilePicture")
+	GetProfile                 = grpc.NewMethod("GetProfile")
+	GetMyProfile               = grpc.NewMethod("GetMyProfile")
+	GetProfileVisibility       = grpc.NewMethod("GetProfileVisibility")
+	UpdateProfileVisibility    = grpc.NewMethod("UpdateProfileVisibility")
+	GetPreferences             = grpc.NewMethod("GetPreferences")
+	UpdatePreferences          = grpc.NewMethod("UpdatePreferences")
+	BlockUser                  = grpc.NewMethod("BlockUser")
+	UnblockUser                = grpc.NewMethod("UnblockUser")
+	ListBlockedUsers           = grpc.NewMethod("ListBlockedUsers")
+	IsBlocked                  = grpc.NewMethod("IsBlocked")
+	EnrollTOTP                 = grpc.NewMethod("EnrollTOTP")
+	ConfirmTOTP                = grpc.NewMethod("ConfirmTOTP")
+	DisableTOTP                = grpc.NewMethod("DisableTOTP")
+	VerifySecondFactor         = grpc.NewMethod("VerifySecondFactor")
+	BeginPasskeyRegistration   = grpc.NewMethod("BeginPasskeyRegistration")
+	FinishPasskeyRegistration  = grpc.NewMethod("FinishPasskeyRegistration")
+	BeginPasskeyAssertion      = grpc.NewMethod("BeginPasskeyAssertion")
+	FinishPasskeyAssertion     = grpc.NewMethod("FinishPasskeyAssertion")
+	ListPasskeys               = grpc.NewMethod("ListPasskeys")
+	RevokePasskey              = grpc.NewMethod("RevokePasskey")
+	ChangePassword             = grpc.NewMethod("ChangePassword")
+	ChangeUsername             = grpc.NewMethod("ChangeUsername")
+	AddEmail                   = grpc.NewMethod("AddEmail")
+	DeleteEmail                = grpc.NewMethod("DeleteEmail")
+	SendVerificationEmail      = grpc.NewMethod("SendVerificationEmail")
+	VerifyEmail                = grpc.NewMethod("VerifyEmail")
+	GetPrimaryEmail            = grpc.NewMethod("GetPrimaryEmail")
+	ChangePrimaryEmail         = grpc.NewMethod("ChangePrimaryEmail")
+	GetActiveEmails            = grpc.NewMethod("GetActiveEmails")
+	ChangePhoneNumber          = grpc.NewMethod("ChangePhoneNumber")
+	GetPhoneNumber             = grpc.NewMethod("GetPhoneNumber")
+	SendVerificationSMS        = grpc.NewMethod("SendVerificationSMS")
+	VerifyPhoneNumber          = grpc.NewMethod("VerifyPhoneNumber")
+	ForgotPassword             = grpc.NewMethod("ForgotPassword")
+	ResetPassword              = grpc.NewMethod("ResetPassword")
+	DeleteUser                 = grpc.NewMethod("DeleteUser")
+)
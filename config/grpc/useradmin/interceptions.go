@@ -0,0 +1,21 @@
+package useradmin
+
+import (
+	"github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+)
+
+// Interceptions is the list of gRPC methods to intercept
+var Interceptions = map[grpc.Method]grpc.Interception{
+	LookUpUser:                grpc.AccessToken,
+	GetUserEmailHistory:       grpc.AccessToken,
+	GetUserPhoneNumberHistory: grpc.AccessToken,
+	GetUserUsernameHistory:    grpc.AccessToken,
+	LockUser:                  grpc.AccessToken,
+	UnlockUser:                grpc.AccessToken,
+	ForcePasswordReset:        grpc.AccessToken,
+	RestoreUser:               grpc.AccessToken,
+	RevokeUserSessions:        grpc.AccessToken,
+	ListUserRoles:             grpc.AccessToken,
+	GrantUserRole:             grpc.AccessToken,
+	RevokeUserRole:            grpc.AccessToken,
+}
@@ -0,0 +1,21 @@
+package useradmin
+
+import (
+	"github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
+)
+
+// User admin service gRPC methods
+var (
+	LookUpUser                = grpc.NewMethod("LookUpUser")
+	GetUserEmailHistory       = grpc.NewMethod("GetUserEmailHistory")
+	GetUserPhoneNumberHistory = grpc.NewMethod("GetUserPhoneNumberHistory")
+	GetUserUsernameHistory    = grpc.NewMethod("GetUserUsernameHistory")
+	LockUser                  = grpc.NewMethod("LockUser")
+	UnlockUser                = grpc.NewMethod("UnlockUser")
+	ForcePasswordReset        = grpc.NewMethod("ForcePasswordReset")
+	RestoreUser               = grpc.NewMethod("RestoreUser")
+	RevokeUserSessions        = grpc.NewMethod("RevokeUserSessions")
+	ListUserRoles             = grpc.NewMethod("ListUserRoles")
+	GrantUserRole             = grpc.NewMethod("GrantUserRole")
+	RevokeUserRole            = grpc.NewMethod("RevokeUserRole")
+)
@@ -2,101 +2,145 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"github.com/go-redis/redis/v8"
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/joho/godotenv"
 	commongcloud "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/cloud/gcloud"
 	commonenv "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/config/env"
 	commonflag "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/config/flag"
-	commonjwtvalidator "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt/validator"
 	commonjwtvalidatorgrpc "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/crypto/jwt/validator/grpc"
 	commonmongodb "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/mongodb"
+	commonredis "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/database/redis"
 	clientauth "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/client/interceptor/auth"
 	serverauth "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/interceptor/auth"
 	commongrpcvalidator "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/grpc/server/validator"
 	commonlistener "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/listener"
 	commontls "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/http/tls"
+	commonutils "github.com/pixel-plaza-dev/uru-databases-2-go-service-common/utils"
 	pbauth "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/compiled/pixel_plaza/auth"
-	pbuser "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/compiled/pixel_plaza/user"
 	pbconfigauth "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/config/grpc/auth"
-	pbconfiguser "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/config/grpc/user"
 	pbtypesgrpc "github.com/pixel-plaza-dev/uru-databases-2-protobuf-common/types/grpc"
 	"github.com/pixel-plaza-dev/uru-databases-2-user-service/app"
+	appcache "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/cache"
+	appclientip "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/clientip"
+	appconfig "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/config"
 	appmongodb "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb"
+	appmongodbmonitor "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb/monitor"
 	userdatabase "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/database/mongodb/user"
 	appgrpc "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc"
+	appgrpcclientmetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/client/interceptor/metrics"
+	appgrpcclientrequestid "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/client/interceptor/requestid"
+	appgrpcserveraccesslog "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/interceptor/accesslog"
+	appgrpcserverauthorization "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/interceptor/authorization"
+	appgrpcserveridempotency "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/interceptor/idempotency"
+	appgrpcservermetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/interceptor/metrics"
+	appgrpcserveroptionalauth "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/interceptor/optionalauth"
+	appgrpcserverratelimiter "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/interceptor/ratelimiter"
+	appgrpcserverrequestid "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/interceptor/requestid"
+	appgrpcservertimeout "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/interceptor/timeout"
 	userserver "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/user"
 	userservervalidator "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/user/validator"
+	useradminserver "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/grpc/server/useradmin"
+	appidempotency "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/idempotency"
 	appjwt "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/jwt"
+	applifecycle "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/lifecycle"
 	applistener "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/listener"
 	applogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger"
+	appstructuredlogger "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/logger/structured"
+	appmail "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/mail"
+	appmetrics "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/metrics"
+	apppasskey "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/passkey"
+	appratelimiter "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/ratelimiter"
+	approle "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/role"
+	apptracing "github.com/pixel-plaza-dev/uru-databases-2-user-service/app/tracing"
+	pbuser "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/user"
+	pbuseradmin "github.com/pixel-plaza-dev/uru-databases-2-user-service/compiled/pixel_plaza/useradmin"
+	pbconfiguser "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/user"
+	pbconfiguseradmin "github.com/pixel-plaza-dev/uru-databases-2-user-service/config/grpc/useradmin"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/credentials/oauth"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"io/fs"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"time"
 )
 
 // Load environment variables
 func init() {
 	// Declare flags and parse them
 	commonflag.SetModeFlag()
+	appconfig.SetFlags()
 	flag.Parse()
-	applogger.Flag.ModeFlagSet(commonflag.Mode)
-
-	// Check if the environment is production
-	if commonflag.Mode != nil && commonflag.Mode.IsProd() {
-		return
-	}
 
-	if err := godotenv.Load(); err != nil {
-		panic(commonenv.FailedToLoadEnvironmentVariablesError)
+	// Load the environment variables file if the environment is not production, it is optional when the
+	// config is loaded from a file
+	if commonflag.Mode == nil || !commonflag.Mode.IsProd() {
+		if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			panic(commonenv.FailedToLoadEnvironmentVariablesError)
+		}
 	}
 }
 
 func main() {
-	// Get the listener port
-	servicePort, err := commonlistener.LoadServicePort(
-		"0.0.0.0",
-		applistener.PortKey,
-	)
+	// Exit with the code set by the lifecycle handler
+	defer commonutils.ExitHandler()
+
+	// Load the config from the file, the environment variables and the flags
+	config, err := appconfig.Load()
 	if err != nil {
 		panic(err)
 	}
-	applogger.Environment.EnvironmentVariableLoaded(applistener.PortKey)
 
-	// Get the MongoDB URI
-	mongoDbUri, err := commonenv.LoadVariable(userdatabase.UriKey)
-	if err != nil {
+	// Print the effective config and exit if requested
+	if appconfig.IsPrintRequested() {
+		if err = config.Print(os.Stdout); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	// Set the log level and the PII redaction policy of the mode
+	if err = appstructuredlogger.SetLevel(commonflag.Mode, config.Logging.Level); err != nil {
+		panic(err)
+	}
+	if err = appstructuredlogger.SetRedaction(
+		commonflag.Mode,
+		config.Logging.RedactEmail,
+		config.Logging.RedactPhone,
+		config.Logging.RedactName,
+	); err != nil {
 		panic(err)
 	}
-	applogger.Environment.EnvironmentVariableLoaded(userdatabase.UriKey)
+	applogger.Flag.ModeFlagSet(commonflag.Mode)
 
-	// Get the required MongoDB database name
-	mongoDbName, err := commonenv.LoadVariable(userdatabase.DbNameKey)
-	if err != nil {
+	// Get the listener ports
+	servicePort := applistener.NewServicePort("0.0.0.0", config.Port)
+	metricsPort := applistener.NewServicePort("0.0.0.0", config.MetricsPort)
 
+	// Create the tracer provider
+	tracerProvider, err := apptracing.NewTracerProvider(
+		context.Background(),
+		config.Tracing.Exporter,
+	)
+	if err != nil {
 		panic(err)
 	}
-	applogger.Environment.EnvironmentVariableLoaded(userdatabase.DbNameKey)
 
 	// Get the gRPC services URI
 	var uriKeys = []string{appgrpc.AuthServiceUriKey}
-	var uris = make(map[string]string)
-	for _, uriKey := range uriKeys {
-		uri, err := commonenv.LoadVariable(uriKey)
-		if err != nil {
-			panic(err)
-		}
-		applogger.Environment.EnvironmentVariableLoaded(uriKey)
-		uris[uriKey] = uri
-	}
-
-	// Get the JWT public key
-	jwtPublicKey, err := commonenv.LoadVariable(appjwt.PublicKey)
-	if err != nil {
-		panic(err)
+	var uris = map[string]string{
+		appgrpc.AuthServiceUriKey: config.Services.AuthUri,
 	}
-	applogger.Environment.EnvironmentVariableLoaded(appjwt.PublicKey)
 
 	// Load Google Cloud service account credentials
 	googleCredentials, err := commongcloud.LoadGoogleCredentials(context.Background())
@@ -118,8 +162,8 @@ func main() {
 
 	// Get the MongoDB configuration
 	mongoDbConfig := &commonmongodb.Config{
-		Uri:     mongoDbUri,
-		Timeout: appmongodb.ConnectionCtxTimeout,
+		Uri:     config.MongoDB.Uri,
+		Timeout: config.MongoDB.ConnectionTimeout,
 	}
 
 	// Get the connection handler
@@ -128,6 +172,15 @@ func main() {
 		panic(err)
 	}
 
+	// Set the MongoDB command and pool monitors
+	mongodbConnection.ClientOptions.SetMonitor(
+		appmongodbmonitor.CombineCommandMonitors(
+			appmongodbmonitor.NewCommandMetrics().Monitor(),
+			otelmongo.NewMonitor(),
+		),
+	)
+	mongodbConnection.ClientOptions.SetPoolMonitor(appmongodbmonitor.NewPoolMonitor())
+
 	// Connect to MongoDB and get the client
 	mongodbClient, err := mongodbConnection.Connect()
 	if err != nil {
@@ -168,46 +221,104 @@ func main() {
 		clientAuthInterceptors[uriKey] = clientAuthInterceptor
 	}
 
+	// Create client metrics and request ID interceptors
+	clientMetricsInterceptor := appgrpcclientmetrics.NewInterceptor()
+	clientRequestIdInterceptor := appgrpcclientrequestid.NewInterceptor()
+
 	// Create gRPC connections
 	var conns = make(map[string]*grpc.ClientConn)
 	for _, uriKey := range uriKeys {
 		conn, err := grpc.NewClient(
 			uris[uriKey], grpc.WithTransportCredentials(transportCredentials),
-			grpc.WithChainUnaryInterceptor(clientAuthInterceptors[uriKey].Authenticate()),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+			grpc.WithChainUnaryInterceptor(
+				clientMetricsInterceptor.Record(),
+				clientAuthInterceptors[uriKey].Authenticate(),
+				clientRequestIdInterceptor.Forward(),
+			),
 		)
 		if err != nil {
 			panic(err)
 		}
 		conns[uriKey] = conn
 	}
-	defer func(conns map[string]*grpc.ClientConn) {
-		for _, conn := range conns {
-			err = conn.Close()
-			if err != nil {
-				panic(err)
-			}
-		}
-	}(conns)
 
 	// Create gRPC server clients
 	authClient := pbauth.NewAuthClient(conns[appgrpc.AuthServiceUriKey])
 
+	// Create the roles client, the auth service stores the roles of the users and the permissions they grant
+	roleClient, err := approle.NewClient(authClient)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create the in-memory cache of the lookups
+	memoryCache, err := appcache.NewMemoryCache(config.Cache.Size, config.Cache.MemoryTTL)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create the shared cache of the lookups if its Redis URI is set
+	var sharedCache *appcache.RedisCache
+	var cacheRedisClient *redis.Client
+	if config.Cache.RedisUri != "" {
+		// Connect to Redis and get the client
+		cacheRedisConnection, err := commonredis.NewDefaultConnectionHandler(
+			&commonredis.Config{
+				Uri:      config.Cache.RedisUri,
+				Password: config.Cache.RedisPassword,
+			},
+		)
+		if err != nil {
+			panic(err)
+		}
+		cacheRedisClient, err = cacheRedisConnection.Connect()
+		if err != nil {
+			panic(err)
+		}
+
+		sharedCache, err = appcache.NewRedisCache(cacheRedisClient, config.Cache.RedisTTL)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Create the tiered cache of the lookups
+	userCache, err := appcache.NewTieredCache(memoryCache, sharedCache, applogger.Cache)
+	if err != nil {
+		panic(err)
+	}
+
 	// Create user database handler
 	userDatabase, err := userdatabase.NewDatabase(
 		mongodbClient,
-		mongoDbName,
+		config.MongoDB.Name,
 		authClient,
+		userCache,
 	)
 	if err != nil {
 		panic(err)
 	}
-	defer func() {
-		// Disconnect from MongoDB
-		mongodbConnection.Disconnect()
-		applogger.MongoDb.DisconnectedFromDatabase()
-	}()
 	applogger.MongoDb.ConnectedToDatabase()
 
+	// Mark the emails added before the email verification as verified. A failed migration doesn't stop the service,
+	// since the migrated batches are kept and the remaining emails are migrated on the next start
+	migratedEmails, err := userDatabase.MigrateUserEmailVerifiedAt(context.Background())
+	if err != nil {
+		applogger.Migration.Error(
+			"Migration failed",
+			slog.String("migration", userdatabase.EmailVerifiedAtMigration),
+			slog.Int64("migrated", migratedEmails),
+			appstructuredlogger.Error(err),
+		)
+	} else if migratedEmails > 0 {
+		applogger.Migration.Info(
+			"Migration applied",
+			slog.String("migration", userdatabase.EmailVerifiedAtMigration),
+			slog.Int64("migrated", migratedEmails),
+		)
+	}
+
 	// Create token validator
 	tokenValidator, err := commonjwtvalidatorgrpc.NewDefaultTokenValidator(
 		tokenSources[appgrpc.AuthServiceUriKey], authClient, nil,
@@ -216,9 +327,35 @@ func main() {
 		panic(err)
 	}
 
-	// Create JWT validator with ED25519 public key
-	jwtValidator, err := commonjwtvalidator.NewEd25519Validator(
-		[]byte(jwtPublicKey),
+	// Get the source of the JWT public keys, either the keys directory, the JWKS file or the single public key
+	jwtKeySource, jwtKeyLoader := "", appjwt.NewPublicKeyLoader(config.JWT.PublicKey)
+	switch {
+	case config.JWT.KeysDir != "":
+		jwtKeySource, jwtKeyLoader = config.JWT.KeysDir, appjwt.NewDirectoryLoader(config.JWT.KeysDir)
+	case config.JWT.JwksFile != "":
+		jwtKeySource, jwtKeyLoader = config.JWT.JwksFile, appjwt.NewJwksLoader(config.JWT.JwksFile)
+	}
+
+	// Load the JWT key set
+	jwtKeySet := appjwt.NewKeySet(config.JWT.KeyOverlap)
+	jwtKeySetReloader, err := appjwt.NewReloader(jwtKeySet, jwtKeyLoader, jwtKeySource, applogger.JwtKeySet)
+	if err != nil {
+		panic(err)
+	}
+	if err = jwtKeySetReloader.Reload(); err != nil {
+		panic(err)
+	}
+
+	// Reload the JWT key set when its source changes or on SIGHUP
+	if jwtKeySource != "" {
+		if err = jwtKeySetReloader.Start(); err != nil {
+			panic(err)
+		}
+	}
+
+	// Create JWT validator with the ED25519 public keys selected by their key ID
+	jwtValidator, err := appjwt.NewKeySetValidator(
+		jwtKeySet,
 		tokenValidator,
 		commonflag.Mode,
 	)
@@ -229,17 +366,146 @@ func main() {
 	// Create server authentication interceptor
 	serverAuthInterceptor, err := serverauth.NewInterceptor(
 		jwtValidator,
-		&pbconfiguser.Interceptions,
+		appgrpc.MergeInterceptions(&pbconfiguser.Interceptions, &pbconfiguseradmin.Interceptions),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create server optional authentication interceptor
+	serverOptionalAuthInterceptor, err := appgrpcserveroptionalauth.NewInterceptor(
+		jwtValidator,
+		&pbconfiguser.OptionalInterceptions,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create server authorization interceptor
+	serverAuthorizationInterceptor, err := appgrpcserverauthorization.NewInterceptor(
+		roleClient,
+		&approle.MethodPermissions,
+		applogger.Authorization,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create the rate limiter
+	var rateLimiter appratelimiter.Limiter
+	var redisClient *redis.Client
+	switch config.RateLimiter.Backend {
+	case appratelimiter.BackendMemory:
+		rateLimiter = appratelimiter.NewMemoryLimiter()
+	case appratelimiter.BackendRedis:
+		// Connect to Redis and get the client
+		redisConnection, err := commonredis.NewDefaultConnectionHandler(
+			&commonredis.Config{
+				Uri:      config.RateLimiter.RedisUri,
+				Password: config.RateLimiter.RedisPassword,
+			},
+		)
+		if err != nil {
+			panic(err)
+		}
+		redisClient, err = redisConnection.Connect()
+		if err != nil {
+			panic(err)
+		}
+
+		rateLimiter, err = appratelimiter.NewRedisLimiter(redisClient)
+		if err != nil {
+			panic(err)
+		}
+	default:
+		panic(appratelimiter.UnknownBackendError)
+	}
+
+	// Load the rate limits, the method limits of the config override the defaults
+	defaultLimit, err := appratelimiter.ParseLimit(config.RateLimiter.DefaultLimit)
+	if err != nil {
+		panic(err)
+	}
+	methodLimits, err := appratelimiter.NewMethodLimits(config.RateLimiter.MethodLimits)
+	if err != nil {
+		panic(err)
+	}
+	exemptCallers, err := appclientip.ParseNetworks(config.RateLimiter.ExemptCallers)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create the resolver of the client IPs forwarded by the trusted proxies
+	clientIPResolver, err := appclientip.NewResolver(config.Network.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create server rate limiter interceptor
+	serverRateLimiterInterceptor, err := appgrpcserverratelimiter.NewInterceptor(
+		rateLimiter,
+		defaultLimit,
+		methodLimits,
+		&appratelimiter.SubjectMethods,
+		clientIPResolver,
+		exemptCallers,
+		applogger.RateLimiter,
 	)
 	if err != nil {
 		panic(err)
 	}
 
+	// Load the query timeouts of the methods, the ones of the config override the defaults
+	methodQueryCtxTimeouts, err := appmongodb.NewMethodQueryCtxTimeouts(config.MongoDB.MethodTimeouts)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create server idempotency interceptor
+	serverIdempotencyInterceptor, err := appgrpcserveridempotency.NewInterceptor(
+		userDatabase,
+		&appidempotency.Methods,
+		methodQueryCtxTimeouts,
+		config.Idempotency.Secret,
+		clientIPResolver,
+		config.Idempotency.TTL,
+		config.MongoDB.QueryTimeout,
+		applogger.Idempotency,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create server timeout interceptor
+	serverTimeoutInterceptor, err := appgrpcservertimeout.NewInterceptor(
+		config.MongoDB.QueryTimeout,
+		methodQueryCtxTimeouts,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create server access log interceptor
+	serverAccessLogInterceptor, err := appgrpcserveraccesslog.NewInterceptor(applogger.AccessLog)
+	if err != nil {
+		panic(err)
+	}
+
 	// Create the gRPC server
 	s := grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(
+			serverAccessLogInterceptor.Log(),
+			appgrpcserverrequestid.NewInterceptor().Attach(),
+			appgrpcservermetrics.NewInterceptor().Record(),
 			serverAuthInterceptor.Authenticate(),
+			serverOptionalAuthInterceptor.Authenticate(),
+			serverAccessLogInterceptor.Identify(),
+			serverAuthorizationInterceptor.Authorize(),
+			serverRateLimiterInterceptor.Limit(),
+			serverIdempotencyInterceptor.Replay(),
+			serverTimeoutInterceptor.Apply(),
 		),
 	)
 
@@ -250,11 +516,44 @@ func main() {
 	userServerValidator, err := userservervalidator.NewValidator(
 		userDatabase,
 		serverValidator,
+		config.Lookup.BatchLimit,
 	)
 	if err != nil {
 		panic(err)
 	}
 
+	// Create the WebAuthn relying party, the passkeys are disabled if its ID is not set
+	var webAuthn *webauthn.WebAuthn
+	if config.Passkey.RPID != "" {
+		webAuthn, err = apppasskey.NewWebAuthn(
+			config.Passkey.RPID,
+			config.Passkey.RPDisplayName,
+			config.Passkey.RPOrigins,
+		)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Create the mailer, the emails are disabled if the SMTP host is not set
+	var mailer *appmail.Mailer
+	if config.Mail.SMTPHost != "" {
+		mailer, err = appmail.NewMailer(
+			appmail.NewSMTPSender(
+				config.Mail.SMTPHost,
+				config.Mail.SMTPPort,
+				config.Mail.SMTPUsername,
+				config.Mail.SMTPPassword,
+				config.Mail.From,
+			),
+			config.Mail.ResetPasswordUrl,
+			config.Mail.VerifyEmailUrl,
+		)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// Create the gRPC user server
 	userServer := userserver.NewServer(
 		userDatabase,
@@ -262,26 +561,137 @@ func main() {
 		applogger.UserServer,
 		userServerValidator,
 		applogger.JwtValidator,
+		webAuthn,
+		config.Passkey.SessionTTL,
+		mailer,
+		config.Mail.ResetPasswordTTL,
+		config.Mail.VerifyEmailTTL,
 	)
 
 	// Register the user server with the gRPC server
 	pbuser.RegisterUserServer(s, userServer)
 
+	// Create the gRPC user admin server and register it with the gRPC server
+	userAdminServer := useradminserver.NewServer(
+		userDatabase,
+		roleClient,
+		mailer,
+		applogger.UserAdminServer,
+	)
+	pbuseradmin.RegisterUserAdminServer(s, userAdminServer)
+
+	// Create the health server and register it with the gRPC server
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+
+	// Create the metrics HTTP server
+	metricsServer := appmetrics.NewServer(metricsPort.FormattedPort)
+
+	// Create the lifecycle handler
+	lifecycleHandler, err := applifecycle.NewHandler(applogger.Lifecycle)
+	if err != nil {
+		panic(err)
+	}
+
+	// Add the shutdown steps in the order they must run
+	for _, step := range []struct {
+		name    string
+		timeout time.Duration
+		stop    applifecycle.StopFunction
+	}{
+		{
+			"health not serving",
+			config.Lifecycle.StepTimeout,
+			applifecycle.SetNotServing(healthServer),
+		},
+		{
+			"gRPC server graceful stop",
+			config.Lifecycle.GracefulStopTimeout,
+			applifecycle.GracefulStop(s),
+		},
+		{
+			"metrics server shutdown",
+			config.Lifecycle.StepTimeout,
+			metricsServer.Shutdown,
+		},
+		{
+			"JWT key set reloader stop",
+			config.Lifecycle.StepTimeout,
+			jwtKeySetReloader.Stop,
+		},
+		{
+			"gRPC client connections close",
+			config.Lifecycle.StepTimeout,
+			applifecycle.CloseClientConnections(conns),
+		},
+		{
+			"MongoDB disconnect",
+			config.Lifecycle.StepTimeout,
+			applifecycle.DisconnectMongoDB(mongodbConnection, applogger.MongoDb),
+		},
+	} {
+		if err = lifecycleHandler.AddStep(step.name, step.timeout, step.stop); err != nil {
+			panic(err)
+		}
+	}
+
+	// Close the Redis client if the rate limiter uses it
+	if redisClient != nil {
+		if err = lifecycleHandler.AddStep(
+			"Redis close",
+			config.Lifecycle.StepTimeout,
+			applifecycle.CloseRedis(redisClient),
+		); err != nil {
+			panic(err)
+		}
+	}
+
+	// Close the Redis client of the shared cache if it is enabled
+	if cacheRedisClient != nil {
+		if err = lifecycleHandler.AddStep(
+			"cache Redis close",
+			config.Lifecycle.StepTimeout,
+			applifecycle.CloseRedis(cacheRedisClient),
+		); err != nil {
+			panic(err)
+		}
+	}
+
+	// Flush the pending spans once everything else is stopped
+	if err = lifecycleHandler.AddStep(
+		"tracer provider shutdown",
+		config.Lifecycle.StepTimeout,
+		tracerProvider.Shutdown,
+	); err != nil {
+		panic(err)
+	}
+
 	// Listen on the given port
 	portListener, err := net.Listen("tcp", servicePort.FormattedPort)
 	if err != nil {
 		panic(commonlistener.FailedToListenError)
 	}
-	defer func() {
-		if err := portListener.Close(); err != nil {
-			panic(commonlistener.FailedToCloseError)
-		}
-	}()
 
 	// Serve the gRPC server
+	serveErr := make(chan error, 2)
+	go func() {
+		if err := s.Serve(portListener); err != nil {
+			serveErr <- err
+		}
+	}()
 	applogger.Listener.ServerStarted(servicePort.Port)
-	if err = s.Serve(portListener); err != nil {
-		panic(commonlistener.FailedToServeError)
-	}
-	defer s.Stop()
+
+	// Serve the metrics HTTP server
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+		}
+	}()
+	applogger.Listener.ServerStarted(metricsPort.Port)
+
+	// Wait for a termination signal, then shut down in order
+	exitCode := lifecycleHandler.WaitForSignal(serveErr)
+	exitCode = lifecycleHandler.Shutdown(exitCode)
+
+	panic(commonutils.Exit{Code: exitCode})
 }